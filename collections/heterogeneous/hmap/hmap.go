@@ -11,6 +11,13 @@ type Map struct {
 	items map[keyInstance]any
 }
 
+// Returns a new, empty heterogeneous map.
+//
+// The zero value of Map is an empty map that cannot be modified.
+func New() Map {
+	return Map{items: make(map[keyInstance]any)}
+}
+
 // Wrapper type to make casts more strict.
 // Relevant for nil and interface types.
 type valueHolder[T any] struct {
@@ -39,7 +46,7 @@ func (m Map) Clear() {
 }
 
 func (m Map) Clone() Map {
-	var clone Map
+	clone := Map{items: make(map[keyInstance]any, len(m.items))}
 	for k, v := range m.items {
 		clone.items[k] = v
 	}
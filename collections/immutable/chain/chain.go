@@ -1,6 +1,8 @@
 package chain
 
 import (
+	"iter"
+
 	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/cursor"
 )
 
@@ -55,7 +57,36 @@ func (c Chain[T]) Cursor() cursor.Cursor[T] {
 	return chainCursor[T]{c.impl}
 }
 
-type chainImpl[T any] interface{ chainImpl(_ T) }
+// Returns a chain containing the elements of `slice`.
+//
+// The slice is not copied, and must therefore not be modified afterwards.
+func FromSlice[T any](slice []T) Chain[T] {
+	if len(slice) == 0 {
+		return Chain[T]{}
+	}
+	return Chain[T]{fromSlice[T]{slice}}
+}
+
+// Returns a chain containing the elements traversed by `cursor`.
+//
+// The first element is advanced to eagerly, so that `Empty` is
+// accurate for the returned chain.
+func FromCursor[T any](cursor cursor.Cursor[T]) Chain[T] {
+	if _, _, ok := cursor.Advance(); !ok {
+		return Chain[T]{}
+	}
+	return Chain[T]{fromCursor[T]{cursor}}
+}
+
+// Returns a chain containing the specified items.
+func New[T any](items ...T) Chain[T] {
+	return FromSlice(items)
+}
+
+type chainImpl[T any] interface {
+	chainImpl(_ T)
+	Cursor() cursor.Cursor[T]
+}
 
 func (_ one[T]) chainImpl(_ T)        {}
 func (_ concat[T]) chainImpl(_ T)     {}
@@ -71,6 +102,19 @@ type concat[T any] struct {
 	right chainImpl[T]
 }
 
+type fromSlice[T any] struct {
+	slice []T
+}
+
+type fromCursor[T any] struct {
+	cursor cursor.Cursor[T]
+}
+
+func (o one[T]) Cursor() cursor.Cursor[T]        { return chainCursor[T]{o} }
+func (c concat[T]) Cursor() cursor.Cursor[T]     { return chainCursor[T]{c} }
+func (s fromSlice[T]) Cursor() cursor.Cursor[T]  { return cursor.FromSlice(s.slice) }
+func (c fromCursor[T]) Cursor() cursor.Cursor[T] { return c.cursor }
+
 type chainCursor[T any] struct {
 	impl chainImpl[T]
 }
@@ -83,7 +127,17 @@ func (c chainCursor[T]) Advance() (T, cursor.Cursor[T], bool) {
 	case one[T]:
 		return i.item, cursor.Empty[T](), true
 	case concat[T]:
-		return cursor.Concat[T](i.left.Cursor(), i.right.Cursor()).Advance()
+		// Rotate left-nested concatenations to the right, so that advancing
+		// the cursor never recurses deeper than a single level.
+		left, right := i.left, i.right
+		for {
+			l, ok := left.(concat[T])
+			if !ok {
+				break
+			}
+			left, right = l.left, concat[T]{l.right, right}
+		}
+		return cursor.Concat[T](left.Cursor(), chainCursor[T]{right}).Advance()
 	case fromSlice[T]:
 		return cursor.FromSlice(i.slice).Advance()
 	case fromCursor[T]:
@@ -92,3 +146,24 @@ func (c chainCursor[T]) Advance() (T, cursor.Cursor[T], bool) {
 		panic("unreachable")
 	}
 }
+
+// Returns an iterator over the elements of the chain.
+func (c Chain[T]) Seq() iter.Seq[T] {
+	return cursor.Seq(c.Cursor())
+}
+
+// Returns an iterator over the indices and elements of the chain.
+func (c Chain[T]) Seq2() iter.Seq2[int, T] {
+	return cursor.Seq2(c.Cursor())
+}
+
+// Returns a chain containing the elements produced by `seq`.
+//
+// The elements are collected eagerly, so `seq` must be finite.
+func FromSeq[T any](seq iter.Seq[T]) Chain[T] {
+	var items []T
+	for t := range seq {
+		items = append(items, t)
+	}
+	return FromSlice(items)
+}
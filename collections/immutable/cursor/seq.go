@@ -0,0 +1,71 @@
+package cursor
+
+import (
+	"iter"
+)
+
+// Returns an iterator over the elements traversed by `cursor`.
+//
+// The cursor itself is not modified, so the iterator can be used multiple times.
+func Seq[T any](cursor Cursor[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for t, next, ok := cursor.Advance(); ok; t, next, ok = next.Advance() {
+			if !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// Returns an iterator over the indices and elements traversed by `cursor`.
+//
+// The cursor itself is not modified, so the iterator can be used multiple times.
+func Seq2[T any](cursor Cursor[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for t := range Seq(cursor) {
+			if !yield(i, t) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Returns a cursor over the elements produced by `seq`, and a function
+// that releases the resources associated with the iteration.
+//
+// Elements are pulled from `seq` on demand, and each element is only pulled once,
+// so that the returned cursor can be advanced any number of times like any other cursor.
+// The iteration is stopped automatically when `seq` is exhausted; callers that
+// stop advancing earlier should call `stop`. After calling `stop`, cursors that
+// would need to pull further elements report that they are exhausted.
+func FromSeq[T any](seq iter.Seq[T]) (cursor Cursor[T], stop func()) {
+	next, stop := iter.Pull(seq)
+	return &seqCursor[T]{pull: next}, stop
+}
+
+// seqCursor memoizes the result of pulling a single element from the underlying iterator.
+type seqCursor[T any] struct {
+	pull   func() (T, bool)
+	pulled bool
+	value  T
+	ok     bool
+	next   *seqCursor[T]
+}
+
+func (c *seqCursor[T]) Advance() (T, Cursor[T], bool) {
+	if !c.pulled {
+		c.value, c.ok = c.pull()
+		c.pulled = true
+		if c.ok {
+			c.next = &seqCursor[T]{pull: c.pull}
+		}
+		// Allow the pull function to be garbage collected.
+		c.pull = nil
+	}
+	if !c.ok {
+		return c.value, emptyCursor[T]{}, false
+	}
+	return c.value, c.next, true
+}
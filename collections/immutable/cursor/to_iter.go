@@ -1,5 +1,9 @@
 package cursor
 
+import (
+	"iter"
+)
+
 // CursorIter implements a mutable iterator over an immutable cursor.
 type CursorIter[C CursorWithSelfType[C, T], T any] struct {
 	Cursor C
@@ -98,3 +102,33 @@ func (it *CursorIterWithCurrent[C, T]) takeNext() *iterState[C, T] {
 	}
 	return &it.current
 }
+
+// Returns an iterator over the remaining elements of `it`.
+//
+// Iterating advances `it`. When the loop is exited early,
+// `it` is positioned after the last element that was yielded.
+func (it *CursorIter[C, T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for t, ok := it.Next(); ok; t, ok = it.Next() {
+			if !yield(t) {
+				return
+			}
+		}
+	}
+}
+
+// Returns an iterator over the remaining elements of `it` and their indices,
+// counted relative to the start of the iteration.
+//
+// See `Seq` for details.
+func (it *CursorIter[C, T]) Seq2() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for t := range it.Seq() {
+			if !yield(i, t) {
+				return
+			}
+			i++
+		}
+	}
+}
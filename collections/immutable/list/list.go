@@ -1,5 +1,11 @@
 package list
 
+import (
+	"iter"
+
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/cursor"
+)
+
 // Represents a simple immutable list.
 type List[T any] struct{ *entry[T] }
 
@@ -73,3 +79,40 @@ func (l *entry[T]) Tail() List[T] {
 		return List[T]{l.tail}
 	}
 }
+
+func (l *entry[T]) Cursor() cursor.Cursor[T] {
+	return listCursor[T]{l}
+}
+
+type listCursor[T any] struct {
+	entry *entry[T]
+}
+
+func (c listCursor[T]) Advance() (T, cursor.Cursor[T], bool) {
+	if c.entry == nil {
+		var t T
+		return t, c, false
+	}
+	return c.entry.head, listCursor[T]{c.entry.tail}, true
+}
+
+// Returns an iterator over the elements of the list.
+func (l *entry[T]) Seq() iter.Seq[T] {
+	return cursor.Seq(l.Cursor())
+}
+
+// Returns an iterator over the indices and elements of the list.
+func (l *entry[T]) Seq2() iter.Seq2[int, T] {
+	return cursor.Seq2(l.Cursor())
+}
+
+// Returns a list containing the elements produced by `seq`.
+//
+// The elements are collected eagerly, so `seq` must be finite.
+func FromSeq[T any](seq iter.Seq[T]) List[T] {
+	var items []T
+	for t := range seq {
+		items = append(items, t)
+	}
+	return New(items...)
+}
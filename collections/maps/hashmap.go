@@ -0,0 +1,150 @@
+package maps
+
+import (
+	"iter"
+
+	"github.com/cr7pt0gr4ph7/functional-go/eq"
+)
+
+// HashMap is a mutable map that compares and hashes its keys using
+// a `eq.Hash[K]` instead of Go's built-in equality, so that K does not
+// need to be comparable (e.g. slices or immutable lists).
+//
+// The zero value is not usable; use `NewHashMap` to create a HashMap.
+type HashMap[K any, V any] struct {
+	hash    eq.Hash[K]
+	buckets map[uint64][]Entry[K, V]
+	len     int
+}
+
+// NewHashMap returns an empty HashMap that uses `hash` for its keys.
+func NewHashMap[K any, V any](hash eq.Hash[K]) *HashMap[K, V] {
+	return &HashMap[K, V]{hash: hash, buckets: make(map[uint64][]Entry[K, V])}
+}
+
+// find returns the hash code of `key` and the index of its entry
+// within the corresponding bucket, or -1 if it is not present.
+func (m *HashMap[K, V]) find(key K) (h uint64, index int) {
+	h = m.hash.Hash(key)
+	for i, e := range m.buckets[h] {
+		if m.hash.Equal(e.Key, key) {
+			return h, i
+		}
+	}
+	return h, -1
+}
+
+func (m *HashMap[K, V]) At(key K) V {
+	return m.AtOrDefault(key)
+}
+
+func (m *HashMap[K, V]) TryAt(key K) (value V, ok bool) {
+	if h, i := m.find(key); i >= 0 {
+		return m.buckets[h][i].Value, true
+	}
+	return
+}
+
+func (m *HashMap[K, V]) AtOrDefault(key K) V {
+	v, _ := m.TryAt(key)
+	return v
+}
+
+func (m *HashMap[K, V]) AtOrElse(key K, fallback V) V {
+	if v, ok := m.TryAt(key); ok {
+		return v
+	}
+	return fallback
+}
+
+func (m *HashMap[K, _]) DefinedAt(key K) bool {
+	_, i := m.find(key)
+	return i >= 0
+}
+
+func (m *HashMap[_, _]) Empty() bool {
+	return m.len == 0
+}
+
+func (m *HashMap[_, _]) Len() int {
+	return m.len
+}
+
+func (m *HashMap[K, V]) Add(entry Entry[K, V]) bool {
+	if !m.DefinedAt(entry.Key) {
+		m.Set(entry)
+		return true
+	}
+	return false
+}
+
+func (m *HashMap[K, V]) Set(entry Entry[K, V]) {
+	m.ReplaceAt(entry.Key, entry.Value)
+}
+
+func (m *HashMap[K, V]) Remove(entry Entry[K, V]) bool {
+	return m.RemoveAt(entry.Key)
+}
+
+func (m *HashMap[K, V]) Clear() {
+	m.buckets = make(map[uint64][]Entry[K, V])
+	m.len = 0
+}
+
+func (m *HashMap[K, V]) InsertAt(key K, value V) {
+	if !m.DefinedAt(key) {
+		m.ReplaceAt(key, value)
+	}
+}
+
+func (m *HashMap[K, V]) ReplaceAt(key K, value V) {
+	h, i := m.find(key)
+	if i >= 0 {
+		m.buckets[h][i].Value = value
+		return
+	}
+	m.buckets[h] = append(m.buckets[h], Entry[K, V]{key, value})
+	m.len++
+}
+
+func (m *HashMap[K, V]) RemoveAt(key K) bool {
+	h, i := m.find(key)
+	if i < 0 {
+		return false
+	}
+	bucket := m.buckets[h]
+	if len(bucket) == 1 {
+		delete(m.buckets, h)
+	} else {
+		// Don't modify the bucket in place, in case an iterator is still using it.
+		r := make([]Entry[K, V], 0, len(bucket)-1)
+		r = append(r, bucket[:i]...)
+		m.buckets[h] = append(r, bucket[i+1:]...)
+	}
+	m.len--
+	return true
+}
+
+// Seq returns an iterator over the entries of `m`, in unspecified order.
+func (m *HashMap[K, V]) Seq() iter.Seq[Entry[K, V]] {
+	return func(yield func(Entry[K, V]) bool) {
+		for _, bucket := range m.buckets {
+			for _, e := range bucket {
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Seq2 returns an iterator over the keys and values of `m`, in unspecified order.
+func (m *HashMap[K, V]) Seq2() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := range m.Seq() {
+			if !yield(e.Key, e.Value) {
+				return
+			}
+		}
+	}
+}
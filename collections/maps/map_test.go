@@ -1,15 +1,40 @@
 package maps
 
 import (
-	"github.com/cr7pt0gr4ph7/functional-go/collections/builders"
-	"github.com/cr7pt0gr4ph7/functional-go/collections/views"
+	"fmt"
+
+	"github.com/cr7pt0gr4ph7/functional-go/collections"
+	"github.com/cr7pt0gr4ph7/functional-go/eq"
 )
 
 // Ensure that all expected interfaces are implemented.
 func _[K comparable, V any]() {
 	var m Map[K, V]
-	var _ views.Keyed[K, V] = m
-	var _ views.Sized = m
-	var _ builders.Keyed[K, V] = m
-	var _ builders.Unordered[Entry[K, V]] = m
+	var _ collections.ReadOnlyKeyed[K, V] = m
+	var _ collections.Sized = m
+	var _ collections.Keyed[K, V] = m
+	var _ collections.Unordered[Entry[K, V]] = m
+
+	var h *HashMap[K, V]
+	var _ collections.ReadOnlyKeyed[K, V] = h
+	var _ collections.Sized = h
+	var _ collections.Keyed[K, V] = h
+	var _ collections.Unordered[Entry[K, V]] = h
+}
+
+func ExampleHashMap() {
+	m := NewHashMap[[]string, int](eq.SliceHash(eq.StringHash[string]()))
+	m.InsertAt([]string{"a", "b"}, 1)
+	m.InsertAt([]string{"a"}, 2)
+	m.InsertAt([]string{"a", "b"}, 3) // already present
+	fmt.Println(m.Len(), m.At([]string{"a", "b"}), m.At([]string{"a"}))
+
+	m.ReplaceAt([]string{"a", "b"}, 4)
+	fmt.Println(m.At([]string{"a", "b"}))
+
+	fmt.Println(m.RemoveAt([]string{"a"}), m.RemoveAt([]string{"a"}), m.Len())
+	// Output:
+	// 2 1 2
+	// 4
+	// true false 1
 }
@@ -0,0 +1,61 @@
+package sets
+
+import (
+	"iter"
+
+	"github.com/cr7pt0gr4ph7/functional-go/collections/maps"
+	"github.com/cr7pt0gr4ph7/functional-go/eq"
+)
+
+// HashSet is a mutable set that compares and hashes its elements using
+// a `eq.Hash[T]` instead of Go's built-in equality, so that T does not
+// need to be comparable.
+//
+// The zero value is not usable; use `NewHashSet` to create a HashSet.
+type HashSet[T any] struct {
+	m *maps.HashMap[T, struct{}]
+}
+
+// NewHashSet returns a HashSet that uses `hash` and contains `elems`.
+func NewHashSet[T any](hash eq.Hash[T], elems ...T) HashSet[T] {
+	s := HashSet[T]{maps.NewHashMap[T, struct{}](hash)}
+	for _, elem := range elems {
+		s.Add(elem)
+	}
+	return s
+}
+
+func (s HashSet[T]) DefinedAt(elem T) bool {
+	return s.m.DefinedAt(elem)
+}
+
+func (s HashSet[_]) Empty() bool {
+	return s.m.Empty()
+}
+
+func (s HashSet[_]) Len() int {
+	return s.m.Len()
+}
+
+func (s HashSet[T]) Add(elem T) bool {
+	return s.m.Add(maps.Entry[T, struct{}]{Key: elem})
+}
+
+func (s HashSet[T]) Remove(elem T) bool {
+	return s.m.RemoveAt(elem)
+}
+
+func (s HashSet[T]) Clear() {
+	s.m.Clear()
+}
+
+// Seq returns an iterator over the elements of `s`, in unspecified order.
+func (s HashSet[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for elem := range s.m.Seq2() {
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
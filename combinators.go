@@ -0,0 +1,379 @@
+package functional
+
+import (
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/cursor"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+	"golang.org/x/exp/constraints"
+)
+
+// foldFunc adapts a plain function to the `FoldLeftFn[A]` interface.
+type foldFunc[A any] func(elem A)
+
+func (f foldFunc[A]) Next(elem A) {
+	f(elem)
+}
+
+// ===================
+// :: Lazy adapters ::
+// ===================
+
+// Map returns a Foldable that applies `f` to each element of `foldable`.
+//
+// No intermediate collection is created: `f` is invoked each time
+// the returned Foldable is folded.
+func Map[FA Foldable[A], A any, B any](foldable FA, f func(elem A) B) Foldable[B] {
+	if c, ok := asIterable[A](foldable); ok {
+		return FromCursor[B](mapCursor[A, B]{c, f})
+	}
+	if seq, ok := asSeq[A](foldable); ok {
+		return FromSeq(mapSeq(seq, f))
+	}
+	return mapped[A, B]{source: foldable, f: f}
+}
+
+type mapped[A any, B any] struct {
+	source Foldable[A]
+	f      func(elem A) B
+}
+
+func (m mapped[A, B]) FoldLeft(fn FoldLeftFn[B]) {
+	m.source.FoldLeft(foldFunc[A](func(elem A) {
+		fn.Next(m.f(elem))
+	}))
+}
+
+// Filter returns a Foldable that only contains the elements of `foldable`
+// for which `predicate` returns true.
+func Filter[FA Foldable[A], A any](foldable FA, predicate func(elem A) bool) Foldable[A] {
+	if c, ok := asIterable[A](foldable); ok {
+		return FromCursor[A](filterCursor[A]{c, predicate})
+	}
+	if seq, ok := asSeq[A](foldable); ok {
+		return FromSeq(filterSeq(seq, predicate))
+	}
+	return filtered[A]{source: foldable, predicate: predicate}
+}
+
+type filtered[A any] struct {
+	source    Foldable[A]
+	predicate func(elem A) bool
+}
+
+func (f filtered[A]) FoldLeft(fn FoldLeftFn[A]) {
+	f.source.FoldLeft(foldFunc[A](func(elem A) {
+		if f.predicate(elem) {
+			fn.Next(elem)
+		}
+	}))
+}
+
+// FlatMap returns a Foldable that contains the elements of all
+// Foldables returned by applying `f` to each element of `foldable`.
+func FlatMap[FA Foldable[A], FB Foldable[B], A any, B any](foldable FA, f func(elem A) FB) Foldable[B] {
+	return flatMapped[A, B, FB]{source: foldable, f: f}
+}
+
+type flatMapped[A any, B any, FB Foldable[B]] struct {
+	source Foldable[A]
+	f      func(elem A) FB
+}
+
+func (m flatMapped[A, B, FB]) FoldLeft(fn FoldLeftFn[B]) {
+	m.source.FoldLeft(foldFunc[A](func(elem A) {
+		m.f(elem).FoldLeft(fn)
+	}))
+}
+
+// Take returns a Foldable that only contains the first `n` elements of `foldable`.
+//
+// Unless `foldable` is `Iterable` or a `SeqFoldable`, folding the result still
+// visits all elements of `foldable`, because `FoldLeft` cannot be stopped early.
+func Take[FA Foldable[A], A any](foldable FA, n int) Foldable[A] {
+	if c, ok := asIterable[A](foldable); ok {
+		return FromCursor[A](takeCursor[A]{c, n})
+	}
+	if seq, ok := asSeq[A](foldable); ok {
+		return FromSeq(takeSeq(seq, n))
+	}
+	return taken[A]{source: foldable, n: n}
+}
+
+type taken[A any] struct {
+	source Foldable[A]
+	n      int
+}
+
+func (t taken[A]) FoldLeft(fn FoldLeftFn[A]) {
+	i := 0
+	t.source.FoldLeft(foldFunc[A](func(elem A) {
+		if i < t.n {
+			fn.Next(elem)
+		}
+		i++
+	}))
+}
+
+// TakeWhile returns a Foldable that contains the longest prefix of `foldable`
+// whose elements all satisfy `predicate`.
+//
+// Unless `foldable` is `Iterable` or a `SeqFoldable`, folding the result still
+// visits all elements of `foldable`, because `FoldLeft` cannot be stopped early.
+func TakeWhile[FA Foldable[A], A any](foldable FA, predicate func(elem A) bool) Foldable[A] {
+	if c, ok := asIterable[A](foldable); ok {
+		return FromCursor[A](takeWhileCursor[A]{c, predicate})
+	}
+	if seq, ok := asSeq[A](foldable); ok {
+		return FromSeq(takeWhileSeq(seq, predicate))
+	}
+	return takenWhile[A]{source: foldable, predicate: predicate}
+}
+
+type takenWhile[A any] struct {
+	source    Foldable[A]
+	predicate func(elem A) bool
+}
+
+func (t takenWhile[A]) FoldLeft(fn FoldLeftFn[A]) {
+	taking := true
+	t.source.FoldLeft(foldFunc[A](func(elem A) {
+		if taking && t.predicate(elem) {
+			fn.Next(elem)
+		} else {
+			taking = false
+		}
+	}))
+}
+
+// Drop returns a Foldable that skips the first `n` elements of `foldable`.
+func Drop[FA Foldable[A], A any](foldable FA, n int) Foldable[A] {
+	if c, ok := asIterable[A](foldable); ok {
+		return FromCursor[A](dropCursor[A]{c, n})
+	}
+	if seq, ok := asSeq[A](foldable); ok {
+		return FromSeq(dropSeq(seq, n))
+	}
+	return dropped[A]{source: foldable, n: n}
+}
+
+type dropped[A any] struct {
+	source Foldable[A]
+	n      int
+}
+
+func (d dropped[A]) FoldLeft(fn FoldLeftFn[A]) {
+	i := 0
+	d.source.FoldLeft(foldFunc[A](func(elem A) {
+		if i >= d.n {
+			fn.Next(elem)
+		}
+		i++
+	}))
+}
+
+// =================
+// :: Terminators ::
+// =================
+
+// Each invokes `f` for each element of `foldable`.
+func Each[FA Foldable[A], A any](foldable FA, f func(elem A)) {
+	foldable.FoldLeft(foldFunc[A](f))
+}
+
+// Count returns the number of elements in `foldable`.
+func Count[FA Foldable[A], A any](foldable FA) int {
+	n := 0
+	foldable.FoldLeft(foldFunc[A](func(_ A) {
+		n++
+	}))
+	return n
+}
+
+// CountFunc returns the number of elements in `foldable` that satisfy `predicate`.
+func CountFunc[FA Foldable[A], A any](foldable FA, predicate func(elem A) bool) int {
+	n := 0
+	foldable.FoldLeft(foldFunc[A](func(elem A) {
+		if predicate(elem) {
+			n++
+		}
+	}))
+	return n
+}
+
+// Exists returns true if at least one element of `foldable` satisfies `predicate`.
+//
+// If `foldable` is `Iterable` or a `SeqFoldable`, the search stops at the first matching element.
+func Exists[FA Foldable[A], A any](foldable FA, predicate func(elem A) bool) bool {
+	return Find(foldable, predicate).IsPresent()
+}
+
+// ForAll returns true if all elements of `foldable` satisfy `predicate`.
+// Returns true for an empty Foldable.
+//
+// If `foldable` is `Iterable` or a `SeqFoldable`, the search stops at the first non-matching element.
+func ForAll[FA Foldable[A], A any](foldable FA, predicate func(elem A) bool) bool {
+	return !Exists(foldable, func(elem A) bool {
+		return !predicate(elem)
+	})
+}
+
+// Contains returns true if `foldable` contains an element equal to `value`.
+func Contains[FA Foldable[A], A comparable](foldable FA, value A) bool {
+	return Exists(foldable, func(elem A) bool {
+		return elem == value
+	})
+}
+
+// Find returns the first element of `foldable` that satisfies `predicate`.
+//
+// If `foldable` is `Iterable` or a `SeqFoldable`, the search stops at the first matching element.
+func Find[FA Foldable[A], A any](foldable FA, predicate func(elem A) bool) option.Optional[A] {
+	result := option.None[A]()
+	eachWhile[A](foldable, func(elem A) bool {
+		if predicate(elem) {
+			result = option.Some(elem)
+			return false
+		}
+		return true
+	})
+	return result
+}
+
+// Reduce combines the elements of `foldable` from left to right using `reduceFn`,
+// using the first element as the initial value.
+// Returns `None` if `foldable` is empty.
+func Reduce[FA Foldable[A], A any](foldable FA, reduceFn func(elem A, acc A) A) option.Optional[A] {
+	var acc A
+	hasValue := false
+	foldable.FoldLeft(foldFunc[A](func(elem A) {
+		if hasValue {
+			acc = reduceFn(elem, acc)
+		} else {
+			acc = elem
+			hasValue = true
+		}
+	}))
+	return option.FromValueOrFalse(acc, hasValue)
+}
+
+// Min returns the smallest element of `foldable`, or `None` if it is empty.
+func Min[FA Foldable[A], A constraints.Ordered](foldable FA) option.Optional[A] {
+	return MinFunc(foldable, func(x A, y A) bool {
+		return x < y
+	})
+}
+
+// Max returns the largest element of `foldable`, or `None` if it is empty.
+func Max[FA Foldable[A], A constraints.Ordered](foldable FA) option.Optional[A] {
+	return MaxFunc(foldable, func(x A, y A) bool {
+		return x < y
+	})
+}
+
+// MinFunc returns the smallest element of `foldable` according to `less`.
+// If there are several smallest elements, the first one is returned.
+func MinFunc[FA Foldable[A], A any](foldable FA, less func(x A, y A) bool) option.Optional[A] {
+	return Reduce(foldable, func(elem A, acc A) A {
+		if less(elem, acc) {
+			return elem
+		}
+		return acc
+	})
+}
+
+// MaxFunc returns the largest element of `foldable` according to `less`.
+// If there are several largest elements, the first one is returned.
+func MaxFunc[FA Foldable[A], A any](foldable FA, less func(x A, y A) bool) option.Optional[A] {
+	return Reduce(foldable, func(elem A, acc A) A {
+		if less(acc, elem) {
+			return elem
+		}
+		return acc
+	})
+}
+
+// ToSlice collects the elements of `foldable` into a newly allocated slice.
+func ToSlice[FA Foldable[A], A any](foldable FA) Slice[A] {
+	var s Slice[A]
+	foldable.FoldLeft(foldFunc[A](func(elem A) {
+		s = append(s, elem)
+	}))
+	return s
+}
+
+// =============
+// :: Cursors ::
+// =============
+
+type mapCursor[A any, B any] struct {
+	source cursor.Cursor[A]
+	f      func(elem A) B
+}
+
+func (c mapCursor[A, B]) Advance() (B, cursor.Cursor[B], bool) {
+	elem, next, ok := c.source.Advance()
+	if !ok {
+		var b B
+		return b, cursor.Empty[B](), false
+	}
+	return c.f(elem), mapCursor[A, B]{next, c.f}, true
+}
+
+type filterCursor[A any] struct {
+	source    cursor.Cursor[A]
+	predicate func(elem A) bool
+}
+
+func (c filterCursor[A]) Advance() (A, cursor.Cursor[A], bool) {
+	for elem, next, ok := c.source.Advance(); ok; elem, next, ok = next.Advance() {
+		if c.predicate(elem) {
+			return elem, filterCursor[A]{next, c.predicate}, true
+		}
+	}
+	var a A
+	return a, cursor.Empty[A](), false
+}
+
+type takeCursor[A any] struct {
+	source cursor.Cursor[A]
+	n      int
+}
+
+func (c takeCursor[A]) Advance() (A, cursor.Cursor[A], bool) {
+	if c.n > 0 {
+		if elem, next, ok := c.source.Advance(); ok {
+			return elem, takeCursor[A]{next, c.n - 1}, true
+		}
+	}
+	var a A
+	return a, cursor.Empty[A](), false
+}
+
+type dropCursor[A any] struct {
+	source cursor.Cursor[A]
+	n      int
+}
+
+func (c dropCursor[A]) Advance() (A, cursor.Cursor[A], bool) {
+	source := c.source
+	for i := 0; i < c.n; i++ {
+		if _, next, ok := source.Advance(); ok {
+			source = next
+		} else {
+			break
+		}
+	}
+	return source.Advance()
+}
+
+type takeWhileCursor[A any] struct {
+	source    cursor.Cursor[A]
+	predicate func(elem A) bool
+}
+
+func (c takeWhileCursor[A]) Advance() (A, cursor.Cursor[A], bool) {
+	if elem, next, ok := c.source.Advance(); ok && c.predicate(elem) {
+		return elem, takeWhileCursor[A]{next, c.predicate}, true
+	}
+	var a A
+	return a, cursor.Empty[A](), false
+}
@@ -0,0 +1,38 @@
+package functional
+
+import (
+	"fmt"
+)
+
+func ExampleMap() {
+	s := Slice[int]{1, 2, 3, 4, 5, 6}
+	evens := Filter(s, func(x int) bool { return x%2 == 0 })
+	squares := Map(evens, func(x int) int { return x * x })
+	fmt.Println(ToSlice(squares))
+	fmt.Println(Count(squares), Max(squares).ValueOrDefault())
+	// Output:
+	// [4 16 36]
+	// 3 36
+}
+
+func ExampleFlatMap() {
+	s := Slice[int]{1, 2, 3}
+	r := FlatMap(s, func(x int) Slice[string] {
+		return Slice[string]{fmt.Sprint(x), fmt.Sprint(-x)}
+	})
+	fmt.Println(ToSlice(Drop(Take(r, 5), 1)))
+	// Output: [-1 2 -2 3]
+}
+
+func ExampleFind() {
+	s := Slice[string]{"apple", "banana", "cherry"}
+	fmt.Println(Find(s, func(x string) bool { return len(x) > 5 }).Value())
+	fmt.Println(Exists(s, func(x string) bool { return x == "kiwi" }))
+	fmt.Println(ForAll(s, func(x string) bool { return len(x) >= 5 }))
+	fmt.Println(Min(Slice[int]{}).IsEmpty())
+	// Output:
+	// banana true
+	// false
+	// true
+	// true
+}
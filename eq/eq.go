@@ -0,0 +1,159 @@
+// Package eq provides the `Eq`, `Ord` and `Hash` dictionaries, which define
+// equality, ordering and hashing for types independently of Go's built-in
+// operators. This allows e.g. slices or structs containing functions
+// to be used as keys of a `maps.HashMap`.
+package eq
+
+import (
+	"cmp"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Eq defines an equivalence relation on A.
+//
+// Equal must be reflexive, symmetric and transitive.
+type Eq[A any] interface {
+	Equal(x A, y A) bool
+}
+
+// Ord defines a total order on A.
+//
+// Compare returns a negative number if x < y, zero if x == y,
+// and a positive number if x > y. Equal must agree with Compare.
+type Ord[A any] interface {
+	Eq[A]
+	Compare(x A, y A) int
+}
+
+// Hash defines a hash function on A.
+//
+// Values that are Equal must have the same hash code.
+type Hash[A any] interface {
+	Eq[A]
+	Hash(x A) uint64
+}
+
+// ======================
+// :: Custom instances ::
+// ======================
+
+// NewEq returns an Eq that uses `equal` to compare values.
+func NewEq[A any](equal func(x A, y A) bool) Eq[A] {
+	return funcEq[A]{equal: equal}
+}
+
+// NewOrd returns an Ord that uses `compare` to compare values.
+func NewOrd[A any](compare func(x A, y A) int) Ord[A] {
+	return funcOrd[A]{compare: compare}
+}
+
+// NewHash returns a Hash that uses `equal` to compare values
+// and `hash` to compute hash codes.
+func NewHash[A any](equal func(x A, y A) bool, hash func(x A) uint64) Hash[A] {
+	return funcHash[A]{funcEq[A]{equal}, hash}
+}
+
+type funcEq[A any] struct {
+	equal func(x A, y A) bool
+}
+
+func (e funcEq[A]) Equal(x A, y A) bool {
+	return e.equal(x, y)
+}
+
+type funcOrd[A any] struct {
+	compare func(x A, y A) int
+}
+
+func (o funcOrd[A]) Equal(x A, y A) bool {
+	return o.compare(x, y) == 0
+}
+
+func (o funcOrd[A]) Compare(x A, y A) int {
+	return o.compare(x, y)
+}
+
+type funcHash[A any] struct {
+	funcEq[A]
+	hash func(x A) uint64
+}
+
+func (h funcHash[A]) Hash(x A) uint64 {
+	return h.hash(x)
+}
+
+// =======================
+// :: Derived instances ::
+// =======================
+
+// EqBy compares values of type A by comparing `key(x)` using `inner`.
+func EqBy[A any, B any](inner Eq[B], key func(x A) B) Eq[A] {
+	return NewEq(func(x A, y A) bool {
+		return inner.Equal(key(x), key(y))
+	})
+}
+
+// OrdBy orders values of type A by ordering `key(x)` using `inner`.
+func OrdBy[A any, B any](inner Ord[B], key func(x A) B) Ord[A] {
+	return NewOrd(func(x A, y A) int {
+		return inner.Compare(key(x), key(y))
+	})
+}
+
+// HashBy hashes values of type A by hashing `key(x)` using `inner`.
+func HashBy[A any, B any](inner Hash[B], key func(x A) B) Hash[A] {
+	return NewHash(func(x A, y A) bool {
+		return inner.Equal(key(x), key(y))
+	}, func(x A) uint64 {
+		return inner.Hash(key(x))
+	})
+}
+
+// Reverse returns the reverse of the order `ord`.
+func Reverse[A any](ord Ord[A]) Ord[A] {
+	return NewOrd(func(x A, y A) int {
+		return ord.Compare(y, x)
+	})
+}
+
+// Less returns true if `x` is ordered before `y` according to `ord`.
+//
+// This allows an Ord to be used with functions like `sort.Slice`,
+// which expect a less function.
+func Less[A any](ord Ord[A], x A, y A) bool {
+	return ord.Compare(x, y) < 0
+}
+
+// ==========================
+// :: Built-in comparisons ::
+// ==========================
+
+// Comparable compares values using Go's built-in `==` operator.
+func Comparable[A comparable]() Eq[A] {
+	return comparableEq[A]{}
+}
+
+type comparableEq[A comparable] struct{}
+
+func (_ comparableEq[A]) Equal(x A, y A) bool {
+	return x == y
+}
+
+// Ordered orders values using Go's built-in `<` operator.
+//
+// Like `cmp.Compare`, it treats NaN values as equal to each other
+// and less than any other floating-point value.
+func Ordered[A constraints.Ordered]() Ord[A] {
+	return orderedOrd[A]{}
+}
+
+type orderedOrd[A constraints.Ordered] struct{}
+
+func (_ orderedOrd[A]) Equal(x A, y A) bool {
+	return cmp.Compare(x, y) == 0
+}
+
+func (_ orderedOrd[A]) Compare(x A, y A) int {
+	return cmp.Compare(x, y)
+}
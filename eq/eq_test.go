@@ -0,0 +1,114 @@
+package eq_test
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/chain"
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/list"
+	"github.com/cr7pt0gr4ph7/functional-go/eq"
+	"github.com/cr7pt0gr4ph7/functional-go/laws"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+	"github.com/cr7pt0gr4ph7/functional-go/quickcheck"
+)
+
+// Small ranges, so that equal values are generated frequently.
+var (
+	ints   = quickcheck.IntRange(-3, 3)
+	floats = quickcheck.Elements(-1, 0, math.Copysign(0, -1), 1, math.NaN(), math.Inf(1))
+	slices = quickcheck.SliceOfN(ints, 0, 3)
+)
+
+func TestPrimitiveInstances(t *testing.T) {
+	laws.Check(t, laws.Config{}, laws.OrdLaws(eq.Ordered[int](), ints)...)
+	laws.Check(t, laws.Config{}, laws.OrdLaws(eq.Ordered[float64](), floats)...)
+	laws.Check(t, laws.Config{}, laws.HashLaws(eq.IntegerHash[int](), ints)...)
+	laws.Check(t, laws.Config{}, laws.HashLaws(eq.FloatHash[float64](), floats)...)
+	laws.Check(t, laws.Config{}, laws.HashLaws(eq.StringHash[string](), quickcheck.Elements("", "a", "b"))...)
+	laws.Check(t, laws.Config{}, laws.HashLaws(eq.BoolHash(), quickcheck.Bool())...)
+}
+
+func TestSliceInstances(t *testing.T) {
+	laws.Check(t, laws.Config{}, laws.OrdLaws(eq.SliceOrd(eq.Ordered[int]()), slices)...)
+	laws.Check(t, laws.Config{}, laws.HashLaws(eq.SliceHash(eq.IntegerHash[int]()), slices)...)
+}
+
+func TestMonadInstances(t *testing.T) {
+	optionals := quickcheck.OptionalOf(ints)
+	laws.Check(t, laws.Config{}, laws.OrdLaws(eq.OptionalOrd(eq.Ordered[int]()), optionals)...)
+	laws.Check(t, laws.Config{}, laws.HashLaws(eq.OptionalHash(eq.IntegerHash[int]()), optionals)...)
+
+	results := quickcheck.ResultOf(ints, quickcheck.Elements(errors.New("a"), errors.New("b")))
+	laws.Check(t, laws.Config{}, laws.HashLaws(eq.ResultHash(eq.IntegerHash[int]()), results)...)
+}
+
+// multiError is an error type that cannot be compared using `==`.
+type multiError []error
+
+func (e multiError) Error() string {
+	return fmt.Sprint([]error(e))
+}
+
+func TestResultEqUncomparableErrors(t *testing.T) {
+	a, b := errors.New("a"), errors.New("b")
+	errs := quickcheck.Elements[error](a, multiError{a}, multiError{a, b}, multiError{a, b}, errors.Join(a, b))
+	results := quickcheck.ResultOf(ints, errs)
+	laws.Check(t, laws.Config{}, laws.HashLaws(eq.ResultHash(eq.IntegerHash[int]()), results)...)
+
+	e := eq.ResultEq(eq.Comparable[int]())
+	if !e.Equal(result.Error[int](multiError{a, b}), result.Error[int](multiError{a, b})) {
+		t.Error("expected equal multiErrors to be equal")
+	}
+	if e.Equal(result.Error[int](multiError{a}), result.Error[int](a)) {
+		t.Error("expected errors of different types to differ")
+	}
+}
+
+func TestCollectionInstances(t *testing.T) {
+	lists := quickcheck.ListOf(quickcheck.IntRange(0, 1))
+	laws.Check(t, laws.Config{}, laws.OrdLaws(eq.ListOrd(eq.Ordered[int]()), lists)...)
+	laws.Check(t, laws.Config{}, laws.HashLaws(eq.ListHash(eq.IntegerHash[int]()), lists)...)
+
+	chains := quickcheck.ChainOf(quickcheck.IntRange(0, 1))
+	laws.Check(t, laws.Config{}, laws.OrdLaws(eq.ChainOrd(eq.Ordered[int]()), chains)...)
+	laws.Check(t, laws.Config{}, laws.HashLaws(eq.ChainHash(eq.IntegerHash[int]()), chains)...)
+}
+
+func ExampleChainEq() {
+	e := eq.ChainEq(eq.Comparable[int]())
+	x := chain.New(1, 2).Concat(chain.New(3))
+	y := chain.New(1).Concat(chain.New(2, 3))
+	fmt.Println(e.Equal(x, y))
+	fmt.Println(e.Equal(x, chain.New(1, 2)))
+	// Output:
+	// true
+	// false
+}
+
+func ExampleOrdBy() {
+	type person struct {
+		name string
+		age  int
+	}
+	people := []person{{"Alice", 30}, {"Bob", 25}, {"Carol", 35}}
+	byAge := eq.Reverse(eq.OrdBy(eq.Ordered[int](), func(p person) int { return p.age }))
+	sort.Slice(people, func(i, j int) bool {
+		return eq.Less(byAge, people[i], people[j])
+	})
+	fmt.Println(people)
+	// Output: [{Carol 35} {Alice 30} {Bob 25}]
+}
+
+func ExampleListOrd() {
+	o := eq.ListOrd(eq.Ordered[string]())
+	fmt.Println(o.Compare(list.New("a", "b"), list.New("a", "c")))
+	fmt.Println(o.Compare(list.New("a", "b"), list.New("a")))
+	fmt.Println(o.Compare(list.Empty[string](), list.Empty[string]()))
+	// Output:
+	// -1
+	// 1
+	// 0
+}
@@ -0,0 +1,349 @@
+package eq
+
+import (
+	"cmp"
+	"hash/maphash"
+	"math"
+	"reflect"
+
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/chain"
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/cursor"
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/list"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+	"golang.org/x/exp/constraints"
+)
+
+// Hash codes of strings depend on this seed,
+// so they differ between runs of the program.
+var seed = maphash.MakeSeed()
+
+// mix scrambles the bits of `x` (using the finalizer of SplitMix64).
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
+
+// combine combines the hash code `h` of a prefix with the hash code `x` of the next element.
+func combine(h uint64, x uint64) uint64 {
+	return mix(h ^ (x + 0x9e3779b97f4a7c15 + (h << 6) + (h >> 2)))
+}
+
+// =========================
+// :: Primitive instances ::
+// =========================
+
+// IntegerHash compares and hashes integers.
+func IntegerHash[A constraints.Integer]() Hash[A] {
+	return integerHash[A]{}
+}
+
+type integerHash[A constraints.Integer] struct{}
+
+func (_ integerHash[A]) Equal(x A, y A) bool {
+	return x == y
+}
+
+func (_ integerHash[A]) Hash(x A) uint64 {
+	return mix(uint64(x))
+}
+
+// FloatHash compares and hashes floating-point numbers.
+//
+// Unlike the built-in `==` operator, it considers NaN to be equal to itself,
+// so that Equal is reflexive (and agrees with `Ordered`).
+func FloatHash[A constraints.Float]() Hash[A] {
+	return floatHash[A]{}
+}
+
+type floatHash[A constraints.Float] struct{}
+
+func (_ floatHash[A]) Equal(x A, y A) bool {
+	return cmp.Compare(x, y) == 0
+}
+
+func (_ floatHash[A]) Hash(x A) uint64 {
+	f := float64(x)
+	switch {
+	case f != f:
+		f = math.NaN()
+	case f == 0:
+		f = 0 // normalize -0 to +0
+	}
+	return mix(math.Float64bits(f))
+}
+
+// StringHash compares and hashes strings.
+func StringHash[A ~string]() Hash[A] {
+	return stringHash[A]{}
+}
+
+type stringHash[A ~string] struct{}
+
+func (_ stringHash[A]) Equal(x A, y A) bool {
+	return x == y
+}
+
+func (_ stringHash[A]) Hash(x A) uint64 {
+	return maphash.String(seed, string(x))
+}
+
+// BoolHash compares and hashes booleans.
+func BoolHash() Hash[bool] {
+	return boolHash{}
+}
+
+type boolHash struct{}
+
+func (_ boolHash) Equal(x bool, y bool) bool {
+	return x == y
+}
+
+func (_ boolHash) Hash(x bool) uint64 {
+	if x {
+		return mix(1)
+	}
+	return mix(0)
+}
+
+// =====================
+// :: Slice instances ::
+// =====================
+
+// SliceEq compares slices elementwise using `inner`.
+func SliceEq[A any](inner Eq[A]) Eq[[]A] {
+	return NewEq(func(x []A, y []A) bool {
+		return sliceEqual(inner, x, y)
+	})
+}
+
+// SliceOrd orders slices lexicographically using `inner`.
+func SliceOrd[A any](inner Ord[A]) Ord[[]A] {
+	return NewOrd(func(x []A, y []A) int {
+		for i := 0; i < len(x) && i < len(y); i++ {
+			if c := inner.Compare(x[i], y[i]); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(len(x), len(y))
+	})
+}
+
+// SliceHash compares and hashes slices elementwise using `inner`.
+func SliceHash[A any](inner Hash[A]) Hash[[]A] {
+	return NewHash(func(x []A, y []A) bool {
+		return sliceEqual[A](inner, x, y)
+	}, func(x []A) uint64 {
+		h := uint64(len(x))
+		for _, a := range x {
+			h = combine(h, inner.Hash(a))
+		}
+		return h
+	})
+}
+
+func sliceEqual[A any](inner Eq[A], x []A, y []A) bool {
+	if len(x) != len(y) {
+		return false
+	}
+	for i := range x {
+		if !inner.Equal(x[i], y[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// =====================
+// :: Monad instances ::
+// =====================
+
+// OptionalEq compares optional values using `inner`.
+// `None` is only equal to itself.
+func OptionalEq[A any](inner Eq[A]) Eq[option.Optional[A]] {
+	return NewEq(func(x option.Optional[A], y option.Optional[A]) bool {
+		xv, xok := x.Value()
+		yv, yok := y.Value()
+		if xok && yok {
+			return inner.Equal(xv, yv)
+		}
+		return xok == yok
+	})
+}
+
+// OptionalOrd orders optional values using `inner`.
+// `None` is ordered before all present values.
+func OptionalOrd[A any](inner Ord[A]) Ord[option.Optional[A]] {
+	return NewOrd(func(x option.Optional[A], y option.Optional[A]) int {
+		return optionalCompare(inner.Compare, x, y)
+	})
+}
+
+// OptionalHash compares and hashes optional values using `inner`.
+func OptionalHash[A any](inner Hash[A]) Hash[option.Optional[A]] {
+	eq := OptionalEq[A](inner)
+	return NewHash(eq.Equal, func(x option.Optional[A]) uint64 {
+		if v, ok := x.Value(); ok {
+			return combine(1, inner.Hash(v))
+		}
+		return 0
+	})
+}
+
+func optionalCompare[A any](compare func(x A, y A) int, x option.Optional[A], y option.Optional[A]) int {
+	xv, xok := x.Value()
+	yv, yok := y.Value()
+	switch {
+	case xok && yok:
+		return compare(xv, yv)
+	case xok:
+		return 1
+	case yok:
+		return -1
+	default:
+		return 0
+	}
+}
+
+// ResultEq compares results using `inner` for values and `errorEqual` for errors.
+// A value is never equal to an error.
+func ResultEq[A any](inner Eq[A]) Eq[result.Result[A]] {
+	return NewEq(func(x result.Result[A], y result.Result[A]) bool {
+		xv, xerr := x.Extract()
+		yv, yerr := y.Extract()
+		if xerr != nil || yerr != nil {
+			return errorEqual(xerr, yerr)
+		}
+		return inner.Equal(xv, yv)
+	})
+}
+
+// errorEqual compares errors using the built-in `==` operator if their
+// dynamic type is comparable, and using `reflect.DeepEqual` otherwise.
+// Unlike `==`, it never panics, e.g. for errors that are slices.
+func errorEqual(x error, y error) bool {
+	if x == nil || y == nil {
+		return x == nil && y == nil
+	}
+	if !reflect.ValueOf(x).Comparable() || !reflect.ValueOf(y).Comparable() {
+		return reflect.DeepEqual(x, y)
+	}
+	return x == y
+}
+
+// ResultHash compares and hashes results using `inner` for values.
+// Errors are compared like in `ResultEq` and hashed by their message.
+func ResultHash[A any](inner Hash[A]) Hash[result.Result[A]] {
+	eq := ResultEq[A](inner)
+	return NewHash(eq.Equal, func(x result.Result[A]) uint64 {
+		v, err := x.Extract()
+		if err != nil {
+			return combine(1, maphash.String(seed, err.Error()))
+		}
+		return combine(0, inner.Hash(v))
+	})
+}
+
+// ==========================
+// :: Collection instances ::
+// ==========================
+
+type iterable[A any] interface {
+	Cursor() cursor.Cursor[A]
+}
+
+// ListEq compares immutable lists elementwise using `inner`.
+func ListEq[A any](inner Eq[A]) Eq[list.List[A]] {
+	return iterableEq[list.List[A]](inner)
+}
+
+// ListOrd orders immutable lists lexicographically using `inner`.
+func ListOrd[A any](inner Ord[A]) Ord[list.List[A]] {
+	return iterableOrd[list.List[A]](inner)
+}
+
+// ListHash compares and hashes immutable lists elementwise using `inner`.
+func ListHash[A any](inner Hash[A]) Hash[list.List[A]] {
+	return iterableHash[list.List[A]](inner)
+}
+
+// ChainEq compares chains elementwise using `inner`.
+//
+// Chains with the same elements are equal, regardless of how they were concatenated.
+func ChainEq[A any](inner Eq[A]) Eq[chain.Chain[A]] {
+	return iterableEq[chain.Chain[A]](inner)
+}
+
+// ChainOrd orders chains lexicographically using `inner`.
+func ChainOrd[A any](inner Ord[A]) Ord[chain.Chain[A]] {
+	return iterableOrd[chain.Chain[A]](inner)
+}
+
+// ChainHash compares and hashes chains elementwise using `inner`.
+func ChainHash[A any](inner Hash[A]) Hash[chain.Chain[A]] {
+	return iterableHash[chain.Chain[A]](inner)
+}
+
+func iterableEq[IA iterable[A], A any](inner Eq[A]) Eq[IA] {
+	return NewEq(func(x IA, y IA) bool {
+		return cursorEqual(inner, x.Cursor(), y.Cursor())
+	})
+}
+
+func iterableOrd[IA iterable[A], A any](inner Ord[A]) Ord[IA] {
+	return NewOrd(func(x IA, y IA) int {
+		return cursorCompare(inner, x.Cursor(), y.Cursor())
+	})
+}
+
+func iterableHash[IA iterable[A], A any](inner Hash[A]) Hash[IA] {
+	return NewHash(func(x IA, y IA) bool {
+		return cursorEqual[A](inner, x.Cursor(), y.Cursor())
+	}, func(x IA) uint64 {
+		h := uint64(0)
+		n := uint64(0)
+		for elem, next, ok := x.Cursor().Advance(); ok; elem, next, ok = next.Advance() {
+			h = combine(h, inner.Hash(elem))
+			n++
+		}
+		return combine(h, n)
+	})
+}
+
+func cursorEqual[A any](inner Eq[A], x cursor.Cursor[A], y cursor.Cursor[A]) bool {
+	for {
+		xv, xnext, xok := x.Advance()
+		yv, ynext, yok := y.Advance()
+		if !xok || !yok {
+			return xok == yok
+		}
+		if !inner.Equal(xv, yv) {
+			return false
+		}
+		x, y = xnext, ynext
+	}
+}
+
+func cursorCompare[A any](inner Ord[A], x cursor.Cursor[A], y cursor.Cursor[A]) int {
+	for {
+		xv, xnext, xok := x.Advance()
+		yv, ynext, yok := y.Advance()
+		switch {
+		case xok && yok:
+			if c := inner.Compare(xv, yv); c != 0 {
+				return c
+			}
+		case xok:
+			return 1
+		case yok:
+			return -1
+		default:
+			return 0
+		}
+		x, y = xnext, ynext
+	}
+}
@@ -0,0 +1,106 @@
+package eval
+
+import (
+	"github.com/cr7pt0gr4ph7/functional-go/monads/effects"
+	"github.com/cr7pt0gr4ph7/functional-go/tuple"
+)
+
+// The combinators in this file are lazy: they do not evaluate their arguments
+// until the returned Eval is evaluated, and they evaluate them from left to right.
+// Like `Map` and `FlatMap`, they do not memoize their result, but memoized
+// arguments (`Later`, `Memoize`) are not evaluated more than once.
+
+// Unit returns an Eval of the unit value.
+func Unit() Eval[effects.Unit] {
+	return Now(effects.UnitValue)
+}
+
+// Void discards the value of `e`.
+func Void[A any](e Eval[A]) Eval[effects.Unit] {
+	return Map(e, func(_ A) effects.Unit {
+		return effects.UnitValue
+	})
+}
+
+// Flatten returns an Eval of the value of the Eval computed by `e`.
+func Flatten[A any](e Eval[Eval[A]]) Eval[A] {
+	return FlatMap(e, func(inner Eval[A]) Eval[A] {
+		return inner
+	})
+}
+
+// Zip returns an Eval of the values of `ea` and `eb`.
+func Zip[A any, B any](ea Eval[A], eb Eval[B]) Eval[tuple.Pair[A, B]] {
+	return Map2(ea, eb, tuple.NewPair[A, B])
+}
+
+// Map2 combines the values of `ea` and `eb` using `combine`.
+func Map2[A any, B any, R any](ea Eval[A], eb Eval[B], combine func(a A, b B) R) Eval[R] {
+	return FlatMap(ea, func(a A) Eval[R] {
+		return Map(eb, func(b B) R {
+			return combine(a, b)
+		})
+	})
+}
+
+// Map3 combines the values of `ea`, `eb` and `ec` using `combine`.
+func Map3[A any, B any, C any, R any](ea Eval[A], eb Eval[B], ec Eval[C], combine func(a A, b B, c C) R) Eval[R] {
+	return FlatMap(ea, func(a A) Eval[R] {
+		return Map2(eb, ec, func(b B, c C) R {
+			return combine(a, b, c)
+		})
+	})
+}
+
+// Map4 combines the values of `ea`, `eb`, `ec` and `ed` using `combine`.
+func Map4[A any, B any, C any, D any, R any](ea Eval[A], eb Eval[B], ec Eval[C], ed Eval[D], combine func(a A, b B, c C, d D) R) Eval[R] {
+	return FlatMap(ea, func(a A) Eval[R] {
+		return Map3(eb, ec, ed, func(b B, c C, d D) R {
+			return combine(a, b, c, d)
+		})
+	})
+}
+
+// Map5 combines the values of `ea`, `eb`, `ec`, `ed` and `ee` using `combine`.
+func Map5[A any, B any, C any, D any, E any, R any](ea Eval[A], eb Eval[B], ec Eval[C], ed Eval[D], ee Eval[E], combine func(a A, b B, c C, d D, e E) R) Eval[R] {
+	return FlatMap(ea, func(a A) Eval[R] {
+		return Map4(eb, ec, ed, ee, func(b B, c C, d D, e E) R {
+			return combine(a, b, c, d, e)
+		})
+	})
+}
+
+// Map6 combines the values of `ea`, `eb`, `ec`, `ed`, `ee` and `ef` using `combine`.
+func Map6[A any, B any, C any, D any, E any, F any, R any](ea Eval[A], eb Eval[B], ec Eval[C], ed Eval[D], ee Eval[E], ef Eval[F], combine func(a A, b B, c C, d D, e E, f F) R) Eval[R] {
+	return FlatMap(ea, func(a A) Eval[R] {
+		return Map5(eb, ec, ed, ee, ef, func(b B, c C, d D, e E, f F) R {
+			return combine(a, b, c, d, e, f)
+		})
+	})
+}
+
+// Traverse applies `f` to each element of `as` and collects the values of the resulting Evals.
+//
+// `f` is only called when the result is evaluated, and evaluation is stack-safe
+// regardless of the length of `as`.
+func Traverse[A any, B any](as []A, f func(a A) Eval[B]) Eval[[]B] {
+	return Defer(func() Eval[[]B] {
+		// A fresh slice for every evaluation, as the result may be evaluated repeatedly.
+		r := Now(make([]B, 0, len(as)))
+		for _, a := range as {
+			r = Map2(r, Defer(func() Eval[B] { return f(a) }), func(bs []B, b B) []B {
+				return append(bs, b)
+			})
+		}
+		return r
+	})
+}
+
+// Sequence collects the values of `es`.
+//
+// See `Traverse` for details.
+func Sequence[A any](es []Eval[A]) Eval[[]A] {
+	return Traverse(es, func(e Eval[A]) Eval[A] {
+		return e
+	})
+}
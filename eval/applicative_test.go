@@ -0,0 +1,88 @@
+package eval
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func ExampleMap3() {
+	greeting := Map3(Now("Hello"), Later(func() string { return "lazy" }), Always(func() int { return 3 }),
+		func(greeting string, name string, times int) string {
+			return greeting + ", " + strings.Repeat(name, times)
+		})
+	fmt.Println(greeting.Value())
+	// Output: Hello, lazylazylazy
+}
+
+func ExampleTraverse() {
+	squares := Traverse([]int{1, 2, 3}, func(x int) Eval[int] {
+		return Later(func() int { return x * x })
+	})
+	fmt.Println(squares.Value())
+	fmt.Println(Zip(Now(1), Flatten(Now(Now("nested")))).Value())
+	// Output:
+	// [1 4 9]
+	// {1 nested}
+}
+
+func TestApplicativeLaziness(t *testing.T) {
+	var order []string
+	provider := func(name string) Eval[string] {
+		return Always(func() string {
+			order = append(order, name)
+			return name
+		})
+	}
+	e := Map6(provider("a"), provider("b"), provider("c"), provider("d"), provider("e"), provider("f"),
+		func(a, b, c, d, e, f string) string { return a + b + c + d + e + f })
+	if len(order) != 0 {
+		t.Fatalf("expected no evaluation before Value, got %v", order)
+	}
+	if e.Value() != "abcdef" || strings.Join(order, "") != "abcdef" {
+		t.Errorf("expected left-to-right evaluation, got %v", order)
+	}
+
+	order = nil
+	traversed := Traverse([]string{"x", "y"}, provider)
+	if len(order) != 0 {
+		t.Fatalf("expected no evaluation before Value, got %v", order)
+	}
+	traversed.Value()
+	traversed.Value()
+	if strings.Join(order, "") != "xyxy" {
+		t.Errorf("expected the unmemoized result to be recomputed, got %v", order)
+	}
+}
+
+func TestApplicativeMemoization(t *testing.T) {
+	calls := 0
+	shared := Later(func() int { calls++; return 1 })
+	e := Map2(shared, shared, func(a, b int) int { return a + b })
+	s := Sequence([]Eval[int]{shared, shared, e})
+	if fmt.Sprint(s.Value()) != "[1 1 2]" || fmt.Sprint(s.Value()) != "[1 1 2]" || calls != 1 {
+		t.Errorf("expected the memoized argument to be evaluated once, got %d calls", calls)
+	}
+
+	results := Sequence([]Eval[int]{Always(func() int { calls++; return calls })})
+	first := results.Value()
+	results.Value()
+	if first[0] != 2 {
+		t.Errorf("a previous result must not be modified by a later evaluation, got %v", first)
+	}
+}
+
+func TestDeepTraverse(t *testing.T) {
+	const n = 1_000_000
+	xs := make([]int, n)
+	withSmallStack(func() {
+		r := Void(Traverse(xs, func(x int) Eval[int] { return Now(x + 1) }))
+		r.Value()
+		if s := Sequence(make([]Eval[int], n)).Value(); len(s) != n {
+			t.Errorf("expected %d elements, got %d", n, len(s))
+		}
+	})
+	if Unit().Value() != Void(Now(1)).Value() {
+		t.Error("Void should return the unit value")
+	}
+}
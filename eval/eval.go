@@ -5,16 +5,34 @@ type Eval[A any] struct {
 	value A
 }
 
+// Memoize returns an Eval that computes the value of `e` at most once,
+// using the `RetryPanics` policy. See `MemoizeWith` for details.
 func (e Eval[A]) Memoize() Eval[A] {
+	return e.MemoizeWith(RetryPanics)
+}
+
+// MemoizeWith returns an Eval that computes the value of `e` at most once,
+// and applies `policy` if the computation panics. Returns `e` itself
+// if it is already memoized, in which case its own policy applies.
+//
+// The result is safe for concurrent use: if multiple goroutines access it
+// while the value is being computed, they wait for that computation instead
+// of starting their own. An Eval must not depend on its own memoized value,
+// as this would deadlock.
+func (e Eval[A]) MemoizeWith(policy PanicPolicy) Eval[A] {
 	if e.impl != nil {
-		return e.impl.Memoize()
+		return e.impl.memoize(policy)
 	}
 	return e
 }
 
+// Value evaluates `e`.
+//
+// Evaluation is stack-safe: arbitrarily deep chains of `FlatMap` and `Defer`
+// run in constant Go stack space.
 func (e Eval[A]) Value() A {
 	if e.impl != nil {
-		return e.impl.Value()
+		return cast[A](run(e.impl))
 	}
 	return e.value
 }
@@ -27,8 +45,17 @@ func Now[A any](value A) Eval[A] {
 	return Eval[A]{value: value}
 }
 
+// Later returns an Eval that calls `valueFactory` on first access and
+// memoizes its result, using the `RetryPanics` policy. Like `Memoize`,
+// it is safe for concurrent use, and calls `valueFactory` only once
+// unless it panics.
 func Later[A any](valueFactory func() A) Eval[A] {
-	return fromImpl[A](&laterImpl[A]{provider: valueFactory})
+	return LaterWith(valueFactory, RetryPanics)
+}
+
+// LaterWith is like `Later`, but applies `policy` if `valueFactory` panics.
+func LaterWith[A any](valueFactory func() A, policy PanicPolicy) Eval[A] {
+	return fromImpl[A](newLater(valueFactory, policy))
 }
 
 func Always[A any](valueFactory func() A) Eval[A] {
@@ -1,9 +1,5 @@
 package eval
 
-import (
-	"github.com/cr7pt0gr4ph7/functional-go/option"
-)
-
 type evalKind byte
 
 const (
@@ -16,9 +12,9 @@ const (
 )
 
 type evalImpl[A any] interface {
+	anyEval
 	kind() evalKind
-	Value() A
-	Memoize() Eval[A]
+	memoize(policy PanicPolicy) Eval[A]
 }
 
 func (n *nowImpl[A]) kind() evalKind        { return kNow }
@@ -33,31 +29,28 @@ type nowImpl[A any] struct {
 	run func() Eval[A]
 }
 
-func (d *nowImpl[A]) Value() A {
-	return d.run().Value()
+func (d *nowImpl[A]) step() (any, bool, anyEval, frame) {
+	return nil, false, erased(d.run()), frame{}
 }
 
-func (d *nowImpl[A]) Memoize() Eval[A] {
-	return wrapWithMemoize(fromImpl[A](d))
+func (d *nowImpl[A]) memoize(policy PanicPolicy) Eval[A] {
+	return wrapWithMemoize(fromImpl[A](d), policy)
 }
 
 // laterImpl provides the implementation for `Later()`.
 type laterImpl[A any] struct {
 	provider func() A
-	result   option.Optional[A]
+	result   memoCell[A]
 }
 
-func (l *laterImpl[A]) Value() A {
-	if v, ok := l.result.Value(); ok {
-		return v
-	} else {
-		r := l.provider()
-		l.result = option.Some(r)
-		return r
+func (l *laterImpl[A]) step() (any, bool, anyEval, frame) {
+	if v, ok := l.result.acquire(); ok {
+		return v, true, nil, frame{}
 	}
+	return l.result.compute(l.provider), true, nil, frame{}
 }
 
-func (l *laterImpl[A]) Memoize() Eval[A] {
+func (l *laterImpl[A]) memoize(_ PanicPolicy) Eval[A] {
 	return fromImpl[A](l)
 }
 
@@ -66,12 +59,12 @@ type alwaysImpl[A any] struct {
 	provider func() A
 }
 
-func (a *alwaysImpl[A]) Value() A {
-	return a.provider()
+func (a *alwaysImpl[A]) step() (any, bool, anyEval, frame) {
+	return a.provider(), true, nil, frame{}
 }
 
-func (a *alwaysImpl[A]) Memoize() Eval[A] {
-	return fromImpl[A](&laterImpl[A]{provider: a.provider})
+func (a *alwaysImpl[A]) memoize(policy PanicPolicy) Eval[A] {
+	return fromImpl[A](newLater(a.provider, policy))
 }
 
 // deferImpl provides the implementation for `Defer()`.
@@ -79,12 +72,12 @@ type deferImpl[A any] struct {
 	run func() Eval[A]
 }
 
-func (d *deferImpl[A]) Value() A {
-	return d.run().Value()
+func (d *deferImpl[A]) step() (any, bool, anyEval, frame) {
+	return nil, false, erased(d.run()), frame{}
 }
 
-func (d *deferImpl[A]) Memoize() Eval[A] {
-	return wrapWithMemoize(fromImpl[A](d))
+func (d *deferImpl[A]) memoize(policy PanicPolicy) Eval[A] {
+	return wrapWithMemoize(fromImpl[A](d), policy)
 }
 
 // flatMapImpl provides the implementation for `FlatMap()` and `Map()`.
@@ -93,38 +86,48 @@ type flatMapImpl[Start any, A any] struct {
 	run   func(start Start) Eval[A]
 }
 
-func (f *flatMapImpl[S, A]) Value() A {
-	return f.run(f.start().Value()).Value()
+func (f *flatMapImpl[S, A]) step() (any, bool, anyEval, frame) {
+	return nil, false, erased(f.start()), frame{cont: func(start any) anyEval {
+		return erased(f.run(cast[S](start)))
+	}}
 }
 
-func (f *flatMapImpl[S, A]) Memoize() Eval[A] {
-	return wrapWithMemoize(fromImpl[A](f))
+func (f *flatMapImpl[S, A]) memoize(policy PanicPolicy) Eval[A] {
+	return wrapWithMemoize(fromImpl[A](f), policy)
 }
 
 // memoizeImpl provides the implementation for `wrapWithMemoize(eval)`.
 type memoizeImpl[A any] struct {
 	eval   Eval[A]
-	result option.Optional[A]
+	result memoCell[A]
 }
 
 func fromImpl[A any, I evalImpl[A]](impl I) Eval[A] {
 	return Eval[A]{impl: impl}
 }
 
-func wrapWithMemoize[A any](eval Eval[A]) Eval[A] {
-	return fromImpl[A](&memoizeImpl[A]{eval: eval})
+func wrapWithMemoize[A any](eval Eval[A], policy PanicPolicy) Eval[A] {
+	return fromImpl[A](&memoizeImpl[A]{eval: eval, result: memoCell[A]{policy: policy}})
 }
 
-func (m *memoizeImpl[A]) Value() A {
-	if v, ok := m.result.Value(); ok {
-		return v
-	} else {
-		r := m.eval.Value()
-		m.result = option.Some(r)
-		return r
+func newLater[A any](provider func() A, policy PanicPolicy) *laterImpl[A] {
+	return &laterImpl[A]{provider: provider, result: memoCell[A]{policy: policy}}
+}
+
+func (m *memoizeImpl[A]) step() (any, bool, anyEval, frame) {
+	if v, ok := m.result.acquire(); ok {
+		return v, true, nil, frame{}
+	}
+	return nil, false, erased(m.eval), frame{
+		cont: func(value any) anyEval {
+			r := cast[A](value)
+			m.result.complete(r)
+			return valueNode[A]{r}
+		},
+		onPanic: m.result.fail,
 	}
 }
 
-func (m *memoizeImpl[A]) Memoize() Eval[A] {
+func (m *memoizeImpl[A]) memoize(_ PanicPolicy) Eval[A] {
 	return Eval[A]{impl: m}
 }
@@ -1,5 +1,11 @@
 package eval
 
+import (
+	"fmt"
+	"runtime/debug"
+	"testing"
+)
+
 func ExampleEval() {
 	x0 := Now(42)
 	x1 := Map(x0, func(x int) int { return x / 3 })
@@ -11,3 +17,58 @@ func ExampleEval() {
 	fmt.Println(x2.Value())
 	// Output: 9.8
 }
+
+// withSmallStack runs `f` with a maximum Go stack size that is far too
+// small for recursive evaluation of the chains used in the tests below.
+func withSmallStack(f func()) {
+	defer debug.SetMaxStack(debug.SetMaxStack(1 << 20))
+	f()
+}
+
+func TestDeepDeferChain(t *testing.T) {
+	var countdown func(n int) Eval[int]
+	countdown = func(n int) Eval[int] {
+		return FlatMap(Now(n), func(n int) Eval[int] {
+			if n == 0 {
+				return Now(0)
+			}
+			return Defer(func() Eval[int] { return countdown(n - 1) })
+		})
+	}
+	withSmallStack(func() {
+		if r := countdown(10_000_000).Value(); r != 0 {
+			t.Errorf("expected 0, got %d", r)
+		}
+	})
+}
+
+func TestDeepLeftNestedFlatMap(t *testing.T) {
+	const n = 1_000_000
+	e := Now(0)
+	for range n {
+		e = Map(e, func(x int) int { return x + 1 })
+	}
+	e = e.Memoize()
+	withSmallStack(func() {
+		if r := e.Value(); r != n {
+			t.Errorf("expected %d, got %d", n, r)
+		}
+	})
+}
+
+func TestMemoizeEvaluatesOnce(t *testing.T) {
+	calls := 0
+	e := Map(Always(func() int { calls++; return 1 }), func(x int) int { return x + 1 }).Memoize()
+	if e.Value() != 2 || e.Value() != 2 || calls != 1 {
+		t.Errorf("expected one evaluation, got %d", calls)
+	}
+}
+
+func TestNilInterfaceValues(t *testing.T) {
+	e := FlatMap(Now[error](nil), func(err error) Eval[any] {
+		return Now[any](err)
+	})
+	if e.Value() != nil {
+		t.Errorf("expected nil, got %v", e.Value())
+	}
+}
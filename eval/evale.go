@@ -0,0 +1,178 @@
+package eval
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+)
+
+// PanicError is the error that `EvalE` reports for a captured panic.
+type PanicError struct {
+	// The value passed to `panic`.
+	Value any
+	// The stack trace of the panicking goroutine, as returned by `debug.Stack`.
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it is an error, so that
+// `errors.Is` and `errors.As` can be used to inspect it.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// catchPanic calls `f`, and returns a PanicError if it panics.
+func catchPanic[T any](f func() T) (value T, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = &PanicError{Value: p, Stack: debug.Stack()}
+		}
+	}()
+	return f(), nil
+}
+
+// EvalE is a lazy computation that can fail with an error.
+//
+// It is an `Eval[result.Result[A]]` whose combinators short-circuit on errors,
+// like `result.FlatMap`. Panics raised by the functions passed to EvalE
+// constructors and combinators are captured as a `*PanicError`, so that
+// they can be handled like any other error.
+type EvalE[A any] struct {
+	eval Eval[result.Result[A]]
+}
+
+// FromResultEval wraps `e` as an EvalE. Panics raised while evaluating `e`
+// are not captured; use `Attempt` for that.
+func FromResultEval[A any](e Eval[result.Result[A]]) EvalE[A] {
+	return EvalE[A]{e}
+}
+
+// NowE returns an EvalE that succeeds with `value`.
+func NowE[A any](value A) EvalE[A] {
+	return EvalE[A]{Now(result.Ok(value))}
+}
+
+// FailE returns an EvalE that fails with `err`.
+func FailE[A any](err error) EvalE[A] {
+	return EvalE[A]{Now(result.Error[A](err))}
+}
+
+func runProvider[A any](provider func() (A, error)) result.Result[A] {
+	r, err := catchPanic(func() result.Result[A] {
+		return result.From(provider())
+	})
+	if err != nil {
+		return result.Error[A](err)
+	}
+	return r
+}
+
+// LaterE is the EvalE counterpart of `Later`.
+// A panic of `provider` is memoized as a `*PanicError`.
+func LaterE[A any](provider func() (A, error)) EvalE[A] {
+	return EvalE[A]{Later(func() result.Result[A] {
+		return runProvider(provider)
+	})}
+}
+
+// AlwaysE is the EvalE counterpart of `Always`.
+func AlwaysE[A any](provider func() (A, error)) EvalE[A] {
+	return EvalE[A]{Always(func() result.Result[A] {
+		return runProvider(provider)
+	})}
+}
+
+// DeferE is the EvalE counterpart of `Defer`.
+func DeferE[A any](deferred func() EvalE[A]) EvalE[A] {
+	return EvalE[A]{Defer(func() Eval[result.Result[A]] {
+		e, err := catchPanic(deferred)
+		if err != nil {
+			return Now(result.Error[A](err))
+		}
+		return e.eval
+	})}
+}
+
+// Attempt returns an EvalE that evaluates `e`, capturing a panic as a `*PanicError`.
+//
+// Like `e` itself, the result is only memoized if `e` is.
+func Attempt[A any](e Eval[A]) EvalE[A] {
+	return AlwaysE(func() (A, error) {
+		return e.Value(), nil
+	})
+}
+
+// Eval returns `e` as an `Eval[result.Result[A]]`.
+func (e EvalE[A]) Eval() Eval[result.Result[A]] {
+	return e.eval
+}
+
+// ToResult evaluates `e`.
+func (e EvalE[A]) ToResult() result.Result[A] {
+	return e.eval.Value()
+}
+
+// Value evaluates `e`.
+func (e EvalE[A]) Value() (A, error) {
+	return e.ToResult().Extract()
+}
+
+// Memoize is the EvalE counterpart of `Eval.Memoize`.
+// Errors, including captured panics, are memoized like values.
+func (e EvalE[A]) Memoize() EvalE[A] {
+	return EvalE[A]{e.eval.Memoize()}
+}
+
+// MapE applies `f` to the value of `e`, if it succeeds.
+func MapE[A any, B any](e EvalE[A], f func(a A) B) EvalE[B] {
+	return FlatMapE(e, func(a A) EvalE[B] {
+		return NowE(f(a))
+	})
+}
+
+// FlatMapE continues with the result of `f` for the value of `e`, if it succeeds.
+func FlatMapE[A any, B any](e EvalE[A], f func(a A) EvalE[B]) EvalE[B] {
+	return EvalE[B]{FlatMap(e.eval, func(r result.Result[A]) Eval[result.Result[B]] {
+		a, err := r.Extract()
+		if err != nil {
+			return Now(result.Error[B](err))
+		}
+		next, err := catchPanic(func() EvalE[B] {
+			return f(a)
+		})
+		if err != nil {
+			return Now(result.Error[B](err))
+		}
+		return next.eval
+	})}
+}
+
+// MapErr applies `f` to the error of `e`, if it fails.
+func MapErr[A any](e EvalE[A], f func(err error) error) EvalE[A] {
+	return Recover(e, func(err error) EvalE[A] {
+		return FailE[A](f(err))
+	})
+}
+
+// Recover continues with the result of `f` for the error of `e`, if it fails.
+// If `f` panics, the result fails with both the original error and the `*PanicError`.
+func Recover[A any](e EvalE[A], f func(err error) EvalE[A]) EvalE[A] {
+	return EvalE[A]{FlatMap(e.eval, func(r result.Result[A]) Eval[result.Result[A]] {
+		if r.IsOk() {
+			return Now(r)
+		}
+		next, err := catchPanic(func() EvalE[A] {
+			return f(r.Error())
+		})
+		if err != nil {
+			return Now(result.Error[A](errors.Join(r.Error(), err)))
+		}
+		return next.eval
+	})}
+}
@@ -0,0 +1,106 @@
+package eval
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func ExampleEvalE() {
+	parse := func(s string) EvalE[int] {
+		return LaterE(func() (int, error) { return strconv.Atoi(s) })
+	}
+	half := func(n int) EvalE[int] {
+		if n%2 != 0 {
+			return FailE[int](fmt.Errorf("%d is odd", n))
+		}
+		return NowE(n / 2)
+	}
+	fmt.Println(FlatMapE(parse("42"), half).Value())
+	fmt.Println(FlatMapE(parse("7"), half).Value())
+	fmt.Println(MapErr(FlatMapE(parse("x"), half), func(err error) error {
+		return errors.New("not a number")
+	}).Value())
+	// Output:
+	// 21 <nil>
+	// 0 7 is odd
+	// 0 not a number
+}
+
+func ExampleAttempt() {
+	e := Attempt(Later(func() []int { return nil }))
+	r := MapE(e, func(s []int) int { return s[3] })
+	_, err := r.Value()
+	fmt.Println(err)
+
+	recovered := Recover(r, func(err error) EvalE[int] {
+		var p *PanicError
+		if errors.As(err, &p) {
+			return NowE(-1)
+		}
+		return FailE[int](err)
+	})
+	fmt.Println(recovered.ToResult().MustBeValue())
+	// Output:
+	// panic: runtime error: index out of range [3] with length 0
+	// -1
+}
+
+func TestPanicErrorStack(t *testing.T) {
+	boom := errors.New("boom")
+	_, err := LaterE(func() (int, error) { panic(boom) }).Value()
+
+	var p *PanicError
+	if !errors.As(err, &p) {
+		t.Fatalf("expected a PanicError, got %v", err)
+	}
+	if !errors.Is(err, boom) {
+		t.Errorf("expected the panic value to be unwrapped")
+	}
+	if !strings.Contains(string(p.Stack), "TestPanicErrorStack") {
+		t.Errorf("expected the stack trace to contain the panicking function, got:\n%s", p.Stack)
+	}
+}
+
+func TestEvalEShortCircuits(t *testing.T) {
+	calls := 0
+	e := FlatMapE(FailE[int](errors.New("failed")), func(n int) EvalE[int] {
+		calls++
+		return NowE(n)
+	})
+	if _, err := e.Value(); err == nil || calls != 0 {
+		t.Errorf("expected an error without calling the continuation, got %v after %d calls", err, calls)
+	}
+}
+
+func TestEvalEMemoize(t *testing.T) {
+	calls := 0
+	e := AlwaysE(func() (int, error) {
+		calls++
+		panic("boom")
+	}).Memoize()
+	e.Value()
+	e.Value()
+	if calls != 1 {
+		t.Errorf("expected the captured panic to be memoized, got %d calls", calls)
+	}
+}
+
+func TestDeepFlatMapE(t *testing.T) {
+	var countdown func(n int) EvalE[int]
+	countdown = func(n int) EvalE[int] {
+		if n == 0 {
+			return NowE(0)
+		}
+		return FlatMapE(NowE(n-1), func(n int) EvalE[int] {
+			return DeferE(func() EvalE[int] { return countdown(n) })
+		})
+	}
+	withSmallStack(func() {
+		if r, err := countdown(1_000_000).Value(); r != 0 || err != nil {
+			t.Errorf("expected 0, got (%d, %v)", r, err)
+		}
+	})
+}
@@ -0,0 +1,115 @@
+package eval
+
+import (
+	"sync"
+)
+
+// PanicPolicy determines what happens when the computation
+// of a memoized value (`Later`, `Memoize`) panics.
+//
+// In either case, the panic is propagated to the goroutine that ran
+// the computation. What the goroutines that were waiting for it observe
+// depends on the policy.
+type PanicPolicy int
+
+const (
+	// RetryPanics does not memoize panics: every later access runs
+	// the computation again, until it succeeds once. Goroutines that
+	// were waiting for the failed computation do not observe the panic;
+	// instead, one of them runs the computation again while the others
+	// keep waiting.
+	RetryPanics PanicPolicy = iota
+	// CachePanics memoizes the panic like a value: waiting goroutines and
+	// every later access panic with the same value without running the
+	// computation again.
+	CachePanics
+)
+
+type cellState byte
+
+const (
+	cellEmpty cellState = iota
+	cellRunning
+	cellDone
+	cellPanicked
+)
+
+// memoCell holds a memoized value and ensures that it is computed
+// at most once, even if it is accessed from multiple goroutines.
+type memoCell[A any] struct {
+	policy     PanicPolicy
+	mu         sync.Mutex
+	state      cellState
+	done       chan struct{} // closed when the current computation finishes
+	value      A
+	panicValue any
+}
+
+// acquire returns the memoized value, or false if the value has not been
+// computed yet. In the latter case, the caller is responsible for computing
+// it and calling either `complete` or `fail`.
+//
+// Blocks while another goroutine computes the value, and panics
+// if the computation panicked and the policy is `CachePanics`.
+func (c *memoCell[A]) acquire() (A, bool) {
+	for {
+		c.mu.Lock()
+		switch c.state {
+		case cellDone:
+			v := c.value
+			c.mu.Unlock()
+			return v, true
+		case cellPanicked:
+			r := c.panicValue
+			c.mu.Unlock()
+			panic(r)
+		case cellRunning:
+			done := c.done
+			c.mu.Unlock()
+			<-done
+		default:
+			c.state = cellRunning
+			c.done = make(chan struct{})
+			c.mu.Unlock()
+			var zero A
+			return zero, false
+		}
+	}
+}
+
+// complete stores the computed value and wakes up all waiting goroutines.
+func (c *memoCell[A]) complete(value A) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.value, c.state = value, cellDone
+	close(c.done)
+}
+
+// fail records a panic of the computation according to the policy
+// and wakes up all waiting goroutines.
+func (c *memoCell[A]) fail(panicValue any) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.policy == CachePanics {
+		c.panicValue, c.state = panicValue, cellPanicked
+	} else {
+		c.state = cellEmpty
+	}
+	close(c.done)
+}
+
+// compute calls `f` and stores its result, or records its panic and re-panics.
+func (c *memoCell[A]) compute(f func() A) A {
+	ok := false
+	defer func() {
+		if !ok {
+			r := recover()
+			c.fail(r)
+			panic(r)
+		}
+	}()
+	v := f()
+	ok = true
+	c.complete(v)
+	return v
+}
@@ -0,0 +1,164 @@
+package eval
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// accessConcurrently calls `f` from `n` goroutines that start at the same time,
+// and returns the values they obtained and the panics they observed.
+func accessConcurrently[A any](n int, f func() A) (values []A, panics []any) {
+	var mu sync.Mutex
+	var start, wg sync.WaitGroup
+	start.Add(1)
+	wg.Add(n)
+	for range n {
+		go func() {
+			defer wg.Done()
+			defer func() {
+				if r := recover(); r != nil {
+					mu.Lock()
+					panics = append(panics, r)
+					mu.Unlock()
+				}
+			}()
+			start.Wait()
+			v := f()
+			mu.Lock()
+			values = append(values, v)
+			mu.Unlock()
+		}()
+	}
+	start.Done()
+	wg.Wait()
+	return
+}
+
+func TestLaterConcurrent(t *testing.T) {
+	var calls atomic.Int32
+	e := Later(func() int {
+		calls.Add(1)
+		return 42
+	})
+	values, panics := accessConcurrently(100, e.Value)
+	if n := calls.Load(); n != 1 {
+		t.Errorf("expected 1 call, got %d", n)
+	}
+	if len(panics) != 0 || len(values) != 100 {
+		t.Fatalf("unexpected results: %d values, panics %v", len(values), panics)
+	}
+	for _, v := range values {
+		if v != 42 {
+			t.Errorf("expected 42, got %d", v)
+		}
+	}
+}
+
+func TestMemoizeConcurrent(t *testing.T) {
+	var calls atomic.Int32
+	e := FlatMap(Always(func() int {
+		calls.Add(1)
+		return 20
+	}), func(x int) Eval[int] {
+		return Map(Now(x), func(x int) int { return x + 1 })
+	}).Memoize()
+	doubled := Map(e, func(x int) int { return 2 * x })
+
+	values, _ := accessConcurrently(100, doubled.Value)
+	if n := calls.Load(); n != 1 {
+		t.Errorf("expected 1 call, got %d", n)
+	}
+	for _, v := range values {
+		if v != 42 {
+			t.Errorf("expected 42, got %d", v)
+		}
+	}
+}
+
+func TestPanicPolicies(t *testing.T) {
+	newEvals := map[string]func(provider func() int, policy PanicPolicy) Eval[int]{
+		"Later": LaterWith[int],
+		"Memoize": func(provider func() int, policy PanicPolicy) Eval[int] {
+			return Map(Always(provider), func(x int) int { return x }).MemoizeWith(policy)
+		},
+	}
+	for name, newEval := range newEvals {
+		for _, policy := range []PanicPolicy{RetryPanics, CachePanics} {
+			calls := 0
+			e := newEval(func() int {
+				calls++
+				if calls == 1 {
+					panic("boom")
+				}
+				return calls
+			}, policy)
+
+			for range 2 {
+				func() {
+					defer func() { recover() }()
+					e.Value()
+				}()
+			}
+
+			var r any
+			func() {
+				defer func() { r = recover() }()
+				e.Value()
+			}()
+			switch policy {
+			case RetryPanics:
+				if calls != 2 || r != nil || e.Value() != 2 {
+					t.Errorf("%s with RetryPanics: expected a retry, got %d calls and panic %v", name, calls, r)
+				}
+			case CachePanics:
+				if calls != 1 || r != "boom" {
+					t.Errorf("%s with CachePanics: expected a cached panic, got %d calls and panic %v", name, calls, r)
+				}
+			}
+		}
+	}
+}
+
+func TestPanicReleasesWaiters(t *testing.T) {
+	for _, policy := range []PanicPolicy{RetryPanics, CachePanics} {
+		var calls atomic.Int32
+		e := LaterWith(func() int {
+			if calls.Add(1) == 1 {
+				// Give the other goroutines time to start waiting.
+				time.Sleep(10 * time.Millisecond)
+				panic("boom")
+			}
+			return 1
+		}, policy)
+		memoized := FlatMap(Now(0), func(int) Eval[int] { return e }).MemoizeWith(policy)
+
+		values, panics := accessConcurrently(50, memoized.Value)
+		for _, p := range panics {
+			if p != "boom" {
+				t.Errorf("%v: unexpected panic %v", policy, p)
+			}
+		}
+		for _, v := range values {
+			if v != 1 {
+				t.Errorf("%v: unexpected value %d", policy, v)
+			}
+		}
+		switch policy {
+		case RetryPanics:
+			// Only the goroutine that ran the computation panics;
+			// the waiting goroutines run it again and succeed.
+			if len(panics) != 1 || len(values) != 49 || calls.Load() != 2 {
+				t.Errorf("RetryPanics: expected 1 panic, 49 values and 2 calls, got %d, %d and %d",
+					len(panics), len(values), calls.Load())
+			}
+		case CachePanics:
+			// The waiting goroutines observe the cached panic.
+			if len(panics) != 50 || calls.Load() != 1 {
+				t.Errorf("CachePanics: expected 50 panics and 1 call, got %d and %d",
+					len(panics), calls.Load())
+			}
+		}
+	}
+}
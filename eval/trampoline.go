@@ -0,0 +1,99 @@
+package eval
+
+// The evaluation of an `Eval` is trampolined: instead of evaluating nested
+// nodes recursively on the Go stack, `run` evaluates them in a loop, keeping
+// the pending continuations on a heap-allocated stack. This makes arbitrarily
+// deep `FlatMap` and `Defer` chains run in constant Go stack space.
+//
+// Since the nodes of a chain have different value types, the loop works on
+// their type-erased view `anyEval`, and values are passed around as `any`.
+
+// anyEval is the type-erased view of an evalImpl used by `run`.
+type anyEval interface {
+	// step performs a single evaluation step. It either returns the value
+	// of the node with `done` set, or the node to evaluate next, together
+	// with an optional frame that receives its value.
+	step() (value any, done bool, next anyEval, k frame)
+}
+
+// continuation receives the value of a node and returns the node to evaluate next.
+type continuation func(value any) anyEval
+
+// frame is an entry of the continuation stack.
+type frame struct {
+	cont continuation
+	// onPanic, if set, is called with the panic value if the evaluation
+	// panics before `cont` is called. Used to release memoization cells.
+	onPanic func(panicValue any)
+}
+
+// erased returns the type-erased view of `e`.
+func erased[A any](e Eval[A]) anyEval {
+	if e.impl == nil {
+		return valueNode[A]{e.value}
+	}
+	return e.impl
+}
+
+// valueNode wraps an already computed value.
+type valueNode[A any] struct {
+	value A
+}
+
+func (v valueNode[A]) step() (any, bool, anyEval, frame) {
+	return v.value, true, nil, frame{}
+}
+
+// cast converts a value passed through `run` back to its static type.
+// Uses the comma-ok form so that nil interface values are handled.
+func cast[A any](value any) A {
+	a, _ := value.(A)
+	return a
+}
+
+// run evaluates `e` using an explicit continuation stack.
+func run(e anyEval) any {
+	var stack []frame
+	finished := false
+	defer func() {
+		if !finished && hasPanicHandlers(stack) {
+			r := recover()
+			for i := len(stack) - 1; i >= 0; i-- {
+				if stack[i].onPanic != nil {
+					stack[i].onPanic(r)
+				}
+			}
+			panic(r)
+		}
+	}()
+	for {
+		value, done, next, k := e.step()
+		if !done {
+			if k.cont != nil {
+				stack = append(stack, k)
+			}
+			e = next
+			continue
+		}
+		if len(stack) == 0 {
+			finished = true
+			return value
+		}
+		k = stack[len(stack)-1]
+		stack[len(stack)-1] = frame{} // allow the continuation to be collected
+		stack = stack[:len(stack)-1]
+		e = k.cont(value)
+	}
+}
+
+// hasPanicHandlers reports whether any frame of `stack` needs to be notified
+// about a panic. If none does, `run` lets the panic pass without recovering it,
+// which preserves its original stack trace.
+func hasPanicHandlers(stack []frame) bool {
+	for _, k := range stack {
+		if k.onPanic != nil {
+			return true
+		}
+	}
+	return false
+}
@@ -1,5 +1,10 @@
 package functional
 
+import (
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/cursor"
+	"github.com/cr7pt0gr4ph7/functional-go/eval"
+)
+
 type Foldable[A any] interface {
 	FoldLeft(fn FoldLeftFn[A])
 }
@@ -26,3 +31,127 @@ func (f *foldImpl[_, S]) Value() S {
 func (f *foldImpl[A, S]) Next(elem A) {
 	f.state = f.next(elem, f.state)
 }
+
+// FoldMap maps each element of `foldable` to a monoid value using `f`,
+// and combines the results from left to right using `monoid`.
+// Returns `monoid.Empty()` if `foldable` is empty.
+func FoldMap[FA Foldable[A], A any, M any](foldable FA, monoid Monoid[M], f func(elem A) M) M {
+	return FoldLeft(foldable, monoid.Empty(), func(elem A, acc M) M {
+		return monoid.Combine(acc, f(elem))
+	})
+}
+
+// Fold combines the elements of `foldable` from left to right using `monoid`.
+// Returns `monoid.Empty()` if `foldable` is empty.
+func Fold[FA Foldable[A], A any](foldable FA, monoid Monoid[A]) A {
+	return FoldLeft(foldable, monoid.Empty(), func(elem A, acc A) A {
+		return monoid.Combine(acc, elem)
+	})
+}
+
+// Iterable is implemented by collections that can provide
+// a cursor over their elements, like `Slice`, `list.List` and `chain.Chain`.
+type Iterable[A any] interface {
+	Cursor() cursor.Cursor[A]
+}
+
+// FoldRight lazily folds `iterable` from the right.
+//
+// `foldFn` receives the remainder of the fold as a lazy `eval.Eval[B]`.
+// If `foldFn` never evaluates it, the remaining elements are never visited,
+// which allows stopping the fold early even for infinite sources.
+//
+// Use `FoldRightFoldable` for foldables that are not `Iterable`.
+func FoldRight[IA Iterable[A], A any, B any](iterable IA, final eval.Eval[B], foldFn func(elem A, rest eval.Eval[B]) eval.Eval[B]) eval.Eval[B] {
+	return FoldRightCursor(iterable.Cursor(), final, foldFn)
+}
+
+// FoldRightFoldable lazily folds `foldable` from the right, like `FoldRight`.
+//
+// If `foldable` is `Iterable`, it is traversed through its cursor.
+// Otherwise, its elements are collected into a slice first, once the
+// result is evaluated, so stopping early does not save visiting them,
+// and infinite sources are not supported.
+func FoldRightFoldable[FA Foldable[A], A any, B any](foldable FA, final eval.Eval[B], foldFn func(elem A, rest eval.Eval[B]) eval.Eval[B]) eval.Eval[B] {
+	if c, ok := asIterable[A](foldable); ok {
+		return FoldRightCursor(c, final, foldFn)
+	}
+	return eval.Defer(func() eval.Eval[B] {
+		return FoldRightCursor(ToSlice(foldable).Cursor(), final, foldFn)
+	})
+}
+
+// FoldRightCursor lazily folds the elements traversed by `c` from the right.
+//
+// See `FoldRight` for details.
+func FoldRightCursor[A any, B any](c cursor.Cursor[A], final eval.Eval[B], foldFn func(elem A, rest eval.Eval[B]) eval.Eval[B]) eval.Eval[B] {
+	return eval.Defer(func() eval.Eval[B] {
+		elem, next, ok := c.Advance()
+		if !ok {
+			return final
+		}
+		return foldFn(elem, FoldRightCursor(next, final, foldFn))
+	})
+}
+
+// CursorFoldable adapts a cursor to the `Foldable` and `Iterable` interfaces.
+type CursorFoldable[A any] struct {
+	cursor cursor.Cursor[A]
+}
+
+// FromCursor returns a Foldable that traverses the elements of `c`.
+func FromCursor[A any](c cursor.Cursor[A]) CursorFoldable[A] {
+	return CursorFoldable[A]{cursor: c}
+}
+
+// FromIterable returns a Foldable that traverses the elements of `iterable`.
+func FromIterable[IA Iterable[A], A any](iterable IA) CursorFoldable[A] {
+	return FromCursor(iterable.Cursor())
+}
+
+func (c CursorFoldable[A]) Cursor() cursor.Cursor[A] {
+	return c.cursor
+}
+
+func (c CursorFoldable[A]) FoldLeft(fn FoldLeftFn[A]) {
+	for elem, next, ok := c.cursor.Advance(); ok; elem, next, ok = next.Advance() {
+		fn.Next(elem)
+	}
+}
+
+// asIterable returns the cursor of `foldable` if it implements `Iterable[A]`.
+func asIterable[A any](foldable Foldable[A]) (cursor.Cursor[A], bool) {
+	if it, ok := foldable.(Iterable[A]); ok {
+		return it.Cursor(), true
+	}
+	return nil, false
+}
+
+// eachWhile invokes `f` for each element of `foldable` until `f` returns false.
+//
+// If `foldable` is `Iterable` or a `SeqFoldable`, the remaining elements
+// are not visited at all. This also holds for infinite sequences.
+func eachWhile[A any](foldable Foldable[A], f func(elem A) bool) {
+	if c, ok := asIterable(foldable); ok {
+		for elem, next, ok := c.Advance(); ok; elem, next, ok = next.Advance() {
+			if !f(elem) {
+				return
+			}
+		}
+		return
+	}
+	if seq, ok := asSeq(foldable); ok {
+		for elem := range seq {
+			if !f(elem) {
+				return
+			}
+		}
+		return
+	}
+	done := false
+	foldable.FoldLeft(foldFunc[A](func(elem A) {
+		if !done && !f(elem) {
+			done = true
+		}
+	}))
+}
@@ -0,0 +1,105 @@
+package functional
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/chain"
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/cursor"
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/list"
+	"github.com/cr7pt0gr4ph7/functional-go/eval"
+)
+
+// naturals is an infinite cursor over the natural numbers.
+type naturals int
+
+func (n naturals) Advance() (int, cursor.Cursor[int], bool) {
+	return int(n), n + 1, true
+}
+
+func ExampleFoldRight() {
+	// Lazily check whether any element is greater than 3,
+	// stopping at the first match.
+	exists := FoldRight(list.New(1, 2, 5, 3), eval.Now(false), func(x int, rest eval.Eval[bool]) eval.Eval[bool] {
+		fmt.Println("visit", x)
+		if x > 3 {
+			return eval.Now(true)
+		}
+		return rest
+	})
+	fmt.Println(exists.Value())
+	// Output:
+	// visit 1
+	// visit 2
+	// visit 5
+	// true
+}
+
+func ExampleFoldRightCursor() {
+	// Terminates despite the source being infinite.
+	firstSquareOver50 := FoldRightCursor[int](naturals(0), eval.Now(-1), func(x int, rest eval.Eval[int]) eval.Eval[int] {
+		if x*x > 50 {
+			return eval.Now(x * x)
+		}
+		return rest
+	})
+	fmt.Println(firstSquareOver50.Value())
+	fmt.Println(ToSlice(Take(Filter(FromCursor[int](naturals(0)), func(x int) bool { return x%3 == 0 }), 4)))
+	// Output:
+	// 64
+	// [0 3 6 9]
+}
+
+func ExampleFromIterable() {
+	c := chain.New(1, 2).Append(3).Concat(chain.New(4, 5))
+	fmt.Println(ToSlice(FromIterable(c)))
+	// Output: [1 2 3 4 5]
+}
+
+func ExampleFoldRightFoldable() {
+	// Foldables that are not Iterable are collected first.
+	evens := Filter(FromSeq(slices.Values([]int{1, 2, 3, 4})), func(x int) bool { return x%2 == 0 })
+	joined := FoldRightFoldable(evens, eval.Now("."), func(x int, rest eval.Eval[string]) eval.Eval[string] {
+		return eval.Map(rest, func(s string) string { return fmt.Sprint(x, s) })
+	})
+	fmt.Println(joined.Value())
+	// Output: 24.
+}
+
+func TestChainFromEmptyCursor(t *testing.T) {
+	if !chain.FromCursor(cursor.Empty[int]()).Empty() {
+		t.Error("expected a chain from an empty cursor to be empty")
+	}
+	if chain.FromCursor(cursor.FromSlice([]int{1})).Empty() {
+		t.Error("expected a chain from a non-empty cursor not to be empty")
+	}
+}
+
+func TestFoldRightStackSafety(t *testing.T) {
+	const n = 1000000
+	s := make(Slice[int], n)
+	for i := range s {
+		s[i] = 1
+	}
+	sum := FoldRight(s, eval.Now(0), func(x int, rest eval.Eval[int]) eval.Eval[int] {
+		return eval.Map(rest, func(acc int) int { return x + acc })
+	})
+	if v := sum.Value(); v != n {
+		t.Errorf("expected %d, got %d", n, v)
+	}
+}
+
+func TestChainCursorLeftNested(t *testing.T) {
+	const n = 1000000
+	var c chain.Chain[int]
+	for i := 0; i < n; i++ {
+		c = c.Append(i)
+	}
+	if l := c.Len(); l != n {
+		t.Errorf("expected %d, got %d", n, l)
+	}
+	if !Exists(FromIterable(c), func(x int) bool { return x == n-1 }) {
+		t.Errorf("expected to find last element")
+	}
+}
@@ -0,0 +1,238 @@
+package funcs
+
+import (
+	"errors"
+	"fmt"
+	"unsafe"
+
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+	"golang.org/x/exp/constraints"
+)
+
+// The functions in this file complement `Add`, `Sub`, `Mul` and `Div`
+// for integer types, which silently wrap around on overflow:
+//
+//   - `Try*` return false on overflow or division by zero.
+//   - `Checked*` return an error wrapping `ErrOverflow` or `ErrDivisionByZero`.
+//   - `Checked*Option` return `option.None` instead.
+//   - `Saturating*` clamp the result to the bounds of the type.
+//   - `Wrapping*` wrap around, making the behavior of the plain operators explicit.
+
+var (
+	ErrOverflow       = errors.New("integer overflow")
+	ErrDivisionByZero = errors.New("integer division by zero")
+)
+
+// ===============
+// :: Type info ::
+// ===============
+
+func isSigned[T constraints.Integer]() bool {
+	var zero T
+	return ^zero < 0
+}
+
+// MinOf returns the smallest value of the integer type T.
+func MinOf[T constraints.Integer]() T {
+	if !isSigned[T]() {
+		return 0
+	}
+	var zero T
+	return T(1) << (unsafe.Sizeof(zero)*8 - 1)
+}
+
+// MaxOf returns the largest value of the integer type T.
+func MaxOf[T constraints.Integer]() T {
+	return ^MinOf[T]()
+}
+
+// =========
+// :: Try ::
+// =========
+
+// TryAdd returns `x + y`, or false if the result overflows.
+func TryAdd[T constraints.Integer](x T, y T) (T, bool) {
+	r := x + y
+	if isSigned[T]() {
+		return r, (y >= 0) == (r >= x)
+	}
+	return r, r >= x
+}
+
+// TrySub returns `x - y`, or false if the result overflows.
+func TrySub[T constraints.Integer](x T, y T) (T, bool) {
+	r := x - y
+	if isSigned[T]() {
+		return r, (y >= 0) == (r <= x)
+	}
+	return r, x >= y
+}
+
+// TryMul returns `x * y`, or false if the result overflows.
+func TryMul[T constraints.Integer](x T, y T) (T, bool) {
+	if x == 0 || y == 0 {
+		return 0, true
+	}
+	r := x * y
+	if isSigned[T]() {
+		// ^T(0) is -1 for signed types.
+		minT := MinOf[T]()
+		if (x == ^T(0) && y == minT) || (y == ^T(0) && x == minT) {
+			return r, false
+		}
+	}
+	return r, r/y == x
+}
+
+// TryDiv returns `x / y`, or false if `y` is zero or the result overflows.
+// The latter only happens for the minimum value of a signed type divided by -1.
+func TryDiv[T constraints.Integer](x T, y T) (T, bool) {
+	if y == 0 {
+		return 0, false
+	}
+	if isSigned[T]() && y == ^T(0) && x == MinOf[T]() {
+		return x, false
+	}
+	return x / y, true
+}
+
+// =============
+// :: Checked ::
+// =============
+
+func checked[T constraints.Integer](r T, ok bool, op string, x T, y T) result.Result[T] {
+	if ok {
+		return result.Ok(r)
+	}
+	if op == "/" && y == 0 {
+		return result.Error[T](fmt.Errorf("%w: %v / %v", ErrDivisionByZero, x, y))
+	}
+	return result.Error[T](fmt.Errorf("%w: %v %s %v", ErrOverflow, x, op, y))
+}
+
+// CheckedAdd returns `x + y`, or an error wrapping `ErrOverflow`.
+func CheckedAdd[T constraints.Integer](x T, y T) result.Result[T] {
+	r, ok := TryAdd(x, y)
+	return checked(r, ok, "+", x, y)
+}
+
+// CheckedSub returns `x - y`, or an error wrapping `ErrOverflow`.
+func CheckedSub[T constraints.Integer](x T, y T) result.Result[T] {
+	r, ok := TrySub(x, y)
+	return checked(r, ok, "-", x, y)
+}
+
+// CheckedMul returns `x * y`, or an error wrapping `ErrOverflow`.
+func CheckedMul[T constraints.Integer](x T, y T) result.Result[T] {
+	r, ok := TryMul(x, y)
+	return checked(r, ok, "*", x, y)
+}
+
+// CheckedDiv returns `x / y`, or an error wrapping
+// `ErrDivisionByZero` or `ErrOverflow`.
+func CheckedDiv[T constraints.Integer](x T, y T) result.Result[T] {
+	r, ok := TryDiv(x, y)
+	return checked(r, ok, "/", x, y)
+}
+
+func checkedOption[T constraints.Integer](r T, ok bool) option.Optional[T] {
+	if ok {
+		return option.Some(r)
+	}
+	return option.None[T]()
+}
+
+// CheckedAddOption returns `x + y`, or None on overflow.
+func CheckedAddOption[T constraints.Integer](x T, y T) option.Optional[T] {
+	return checkedOption(TryAdd(x, y))
+}
+
+// CheckedSubOption returns `x - y`, or None on overflow.
+func CheckedSubOption[T constraints.Integer](x T, y T) option.Optional[T] {
+	return checkedOption(TrySub(x, y))
+}
+
+// CheckedMulOption returns `x * y`, or None on overflow.
+func CheckedMulOption[T constraints.Integer](x T, y T) option.Optional[T] {
+	return checkedOption(TryMul(x, y))
+}
+
+// CheckedDivOption returns `x / y`, or None on overflow or division by zero.
+func CheckedDivOption[T constraints.Integer](x T, y T) option.Optional[T] {
+	return checkedOption(TryDiv(x, y))
+}
+
+// ================
+// :: Saturating ::
+// ================
+
+// SaturatingAdd returns `x + y`, clamped to the bounds of T.
+func SaturatingAdd[T constraints.Integer](x T, y T) T {
+	if r, ok := TryAdd(x, y); ok {
+		return r
+	}
+	if y < 0 {
+		return MinOf[T]()
+	}
+	return MaxOf[T]()
+}
+
+// SaturatingSub returns `x - y`, clamped to the bounds of T.
+func SaturatingSub[T constraints.Integer](x T, y T) T {
+	if r, ok := TrySub(x, y); ok {
+		return r
+	}
+	if y < 0 {
+		return MaxOf[T]()
+	}
+	return MinOf[T]()
+}
+
+// SaturatingMul returns `x * y`, clamped to the bounds of T.
+func SaturatingMul[T constraints.Integer](x T, y T) T {
+	if r, ok := TryMul(x, y); ok {
+		return r
+	}
+	if (x < 0) != (y < 0) {
+		return MinOf[T]()
+	}
+	return MaxOf[T]()
+}
+
+// SaturatingDiv returns `x / y`, clamped to the bounds of T.
+// Like the `/` operator, it panics if `y` is zero.
+func SaturatingDiv[T constraints.Integer](x T, y T) T {
+	if y == 0 {
+		panic(ErrDivisionByZero)
+	}
+	if r, ok := TryDiv(x, y); ok {
+		return r
+	}
+	return MaxOf[T]()
+}
+
+// ==============
+// :: Wrapping ::
+// ==============
+
+// WrappingAdd returns `x + y`, wrapping around on overflow.
+func WrappingAdd[T constraints.Integer](x T, y T) T {
+	return x + y
+}
+
+// WrappingSub returns `x - y`, wrapping around on overflow.
+func WrappingSub[T constraints.Integer](x T, y T) T {
+	return x - y
+}
+
+// WrappingMul returns `x * y`, wrapping around on overflow.
+func WrappingMul[T constraints.Integer](x T, y T) T {
+	return x * y
+}
+
+// WrappingDiv returns `x / y`, wrapping around on overflow.
+// Like the `/` operator, it panics if `y` is zero.
+func WrappingDiv[T constraints.Integer](x T, y T) T {
+	return x / y
+}
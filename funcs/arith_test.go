@@ -0,0 +1,113 @@
+package funcs
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"golang.org/x/exp/constraints"
+)
+
+func ExampleCheckedAdd() {
+	fmt.Println(CheckedAdd[int8](100, 27).Extract())
+	fmt.Println(CheckedAdd[int8](100, 28).Extract())
+	fmt.Println(CheckedDiv(1, 0).Extract())
+	// Output:
+	// 127 <nil>
+	// 0 integer overflow: 100 + 28
+	// 0 integer division by zero: 1 / 0
+}
+
+func ExampleSaturatingSub() {
+	fmt.Println(SaturatingSub[uint8](3, 5), SaturatingSub[int8](-100, 100), SaturatingMul[int16](-300, 300))
+	// Output: 0 -128 -32768
+}
+
+// checkExhaustive compares the Try* functions against exact results
+// computed with big integers for all pairs of values of T.
+func checkExhaustive[T constraints.Integer](t *testing.T) {
+	lo, hi := int64(MinOf[T]()), int64(MaxOf[T]())
+	clamp := func(r *big.Int) (T, bool) {
+		if r.IsInt64() && r.Int64() >= lo && r.Int64() <= hi {
+			return T(r.Int64()), true
+		}
+		return 0, false
+	}
+	ops := []struct {
+		name  string
+		try   func(x T, y T) (T, bool)
+		sat   func(x T, y T) T
+		exact func(r, x, y *big.Int) *big.Int
+	}{
+		{"+", TryAdd[T], SaturatingAdd[T], (*big.Int).Add},
+		{"-", TrySub[T], SaturatingSub[T], (*big.Int).Sub},
+		{"*", TryMul[T], SaturatingMul[T], (*big.Int).Mul},
+		{"/", TryDiv[T], nil, (*big.Int).Quo},
+	}
+	for x := lo; x <= hi; x++ {
+		for y := lo; y <= hi; y++ {
+			for _, op := range ops {
+				if op.name == "/" && y == 0 {
+					if _, ok := op.try(T(x), T(y)); ok {
+						t.Fatalf("%d / 0 should fail", x)
+					}
+					continue
+				}
+				exact := op.exact(new(big.Int), big.NewInt(x), big.NewInt(y))
+				want, wantOk := clamp(exact)
+				got, ok := op.try(T(x), T(y))
+				if ok != wantOk || (ok && got != want) {
+					t.Fatalf("%d %s %d: got (%d, %v), want (%d, %v)", x, op.name, y, got, ok, want, wantOk)
+				}
+				if op.sat != nil {
+					sat := op.sat(T(x), T(y))
+					wantSat := want
+					if !wantOk {
+						if exact.Sign() < 0 {
+							wantSat = T(lo)
+						} else {
+							wantSat = T(hi)
+						}
+					}
+					if sat != wantSat {
+						t.Fatalf("saturating %d %s %d: got %d, want %d", x, op.name, y, sat, wantSat)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestArithExhaustive(t *testing.T) {
+	checkExhaustive[int8](t)
+	checkExhaustive[uint8](t)
+}
+
+func TestArithBounds(t *testing.T) {
+	if MinOf[int64]() != math.MinInt64 || MaxOf[int64]() != math.MaxInt64 {
+		t.Error("wrong bounds for int64")
+	}
+	if MinOf[uint64]() != 0 || MaxOf[uint64]() != math.MaxUint64 {
+		t.Error("wrong bounds for uint64")
+	}
+	if _, ok := TryMul[int64](math.MinInt64, -1); ok {
+		t.Error("MinInt64 * -1 should overflow")
+	}
+	if _, ok := TryAdd[uint64](math.MaxUint64, 1); ok {
+		t.Error("MaxUint64 + 1 should overflow")
+	}
+	if err := CheckedDiv[int32](math.MinInt32, -1).Error(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("expected ErrOverflow, got %v", err)
+	}
+	if SaturatingDiv[int32](math.MinInt32, -1) != math.MaxInt32 {
+		t.Error("MinInt32 / -1 should saturate to MaxInt32")
+	}
+	if WrappingAdd[int32](math.MaxInt32, 1) != math.MinInt32 {
+		t.Error("MaxInt32 + 1 should wrap to MinInt32")
+	}
+	if CheckedSubOption[uint](0, 1).IsPresent() {
+		t.Error("0 - 1 should overflow for uint")
+	}
+}
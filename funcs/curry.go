@@ -0,0 +1,167 @@
+package funcs
+
+// ==============
+// :: Currying ::
+// ==============
+
+// Curry2 converts a function of 2 arguments into a chain of functions of one argument each.
+func Curry2[A any, B any, R any](fn func(a A, b B) R) func(a A) func(b B) R {
+	return func(a A) func(b B) R {
+		return func(b B) R {
+			return fn(a, b)
+		}
+	}
+}
+
+// Uncurry2 is the inverse of `Curry2`.
+func Uncurry2[A any, B any, R any](fn func(a A) func(b B) R) func(a A, b B) R {
+	return func(a A, b B) R {
+		return fn(a)(b)
+	}
+}
+
+// Curry3 is the 3-argument version of `Curry2`.
+func Curry3[A any, B any, C any, R any](fn func(a A, b B, c C) R) func(a A) func(b B) func(c C) R {
+	return func(a A) func(b B) func(c C) R {
+		return func(b B) func(c C) R {
+			return func(c C) R {
+				return fn(a, b, c)
+			}
+		}
+	}
+}
+
+// Uncurry3 is the inverse of `Curry3`.
+func Uncurry3[A any, B any, C any, R any](fn func(a A) func(b B) func(c C) R) func(a A, b B, c C) R {
+	return func(a A, b B, c C) R {
+		return fn(a)(b)(c)
+	}
+}
+
+// Curry4 is the 4-argument version of `Curry2`.
+func Curry4[A any, B any, C any, D any, R any](fn func(a A, b B, c C, d D) R) func(a A) func(b B) func(c C) func(d D) R {
+	return func(a A) func(b B) func(c C) func(d D) R {
+		return func(b B) func(c C) func(d D) R {
+			return func(c C) func(d D) R {
+				return func(d D) R {
+					return fn(a, b, c, d)
+				}
+			}
+		}
+	}
+}
+
+// Uncurry4 is the inverse of `Curry4`.
+func Uncurry4[A any, B any, C any, D any, R any](fn func(a A) func(b B) func(c C) func(d D) R) func(a A, b B, c C, d D) R {
+	return func(a A, b B, c C, d D) R {
+		return fn(a)(b)(c)(d)
+	}
+}
+
+// Curry5 is the 5-argument version of `Curry2`.
+func Curry5[A any, B any, C any, D any, E any, R any](fn func(a A, b B, c C, d D, e E) R) func(a A) func(b B) func(c C) func(d D) func(e E) R {
+	return func(a A) func(b B) func(c C) func(d D) func(e E) R {
+		return func(b B) func(c C) func(d D) func(e E) R {
+			return func(c C) func(d D) func(e E) R {
+				return func(d D) func(e E) R {
+					return func(e E) R {
+						return fn(a, b, c, d, e)
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurry5 is the inverse of `Curry5`.
+func Uncurry5[A any, B any, C any, D any, E any, R any](fn func(a A) func(b B) func(c C) func(d D) func(e E) R) func(a A, b B, c C, d D, e E) R {
+	return func(a A, b B, c C, d D, e E) R {
+		return fn(a)(b)(c)(d)(e)
+	}
+}
+
+// Curry6 is the 6-argument version of `Curry2`.
+func Curry6[A any, B any, C any, D any, E any, F any, R any](fn func(a A, b B, c C, d D, e E, f F) R) func(a A) func(b B) func(c C) func(d D) func(e E) func(f F) R {
+	return func(a A) func(b B) func(c C) func(d D) func(e E) func(f F) R {
+		return func(b B) func(c C) func(d D) func(e E) func(f F) R {
+			return func(c C) func(d D) func(e E) func(f F) R {
+				return func(d D) func(e E) func(f F) R {
+					return func(e E) func(f F) R {
+						return func(f F) R {
+							return fn(a, b, c, d, e, f)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// Uncurry6 is the inverse of `Curry6`.
+func Uncurry6[A any, B any, C any, D any, E any, F any, R any](fn func(a A) func(b B) func(c C) func(d D) func(e E) func(f F) R) func(a A, b B, c C, d D, e E, f F) R {
+	return func(a A, b B, c C, d D, e E, f F) R {
+		return fn(a)(b)(c)(d)(e)(f)
+	}
+}
+
+// =========================
+// :: Partial application ::
+// =========================
+
+// Partial2 fixes the first argument of a function of 2 arguments.
+func Partial2[A any, B any, R any](fn func(a A, b B) R, a A) func(b B) R {
+	return func(b B) R {
+		return fn(a, b)
+	}
+}
+
+// Partial3 is the 3-argument version of `Partial2`.
+func Partial3[A any, B any, C any, R any](fn func(a A, b B, c C) R, a A) func(b B, c C) R {
+	return func(b B, c C) R {
+		return fn(a, b, c)
+	}
+}
+
+// Partial4 is the 4-argument version of `Partial2`.
+func Partial4[A any, B any, C any, D any, R any](fn func(a A, b B, c C, d D) R, a A) func(b B, c C, d D) R {
+	return func(b B, c C, d D) R {
+		return fn(a, b, c, d)
+	}
+}
+
+// Partial5 is the 5-argument version of `Partial2`.
+func Partial5[A any, B any, C any, D any, E any, R any](fn func(a A, b B, c C, d D, e E) R, a A) func(b B, c C, d D, e E) R {
+	return func(b B, c C, d D, e E) R {
+		return fn(a, b, c, d, e)
+	}
+}
+
+// Partial6 is the 6-argument version of `Partial2`.
+func Partial6[A any, B any, C any, D any, E any, F any, R any](fn func(a A, b B, c C, d D, e E, f F) R, a A) func(b B, c C, d D, e E, f F) R {
+	return func(b B, c C, d D, e E, f F) R {
+		return fn(a, b, c, d, e, f)
+	}
+}
+
+// Flip swaps the arguments of a function of two arguments.
+func Flip[A any, B any, R any](fn func(a A, b B) R) func(b B, a A) R {
+	return func(b B, a A) R {
+		return fn(a, b)
+	}
+}
+
+// Const returns a function that ignores its argument and always returns `value`.
+func Const[A any, B any](value A) func(_ B) A {
+	return func(_ B) A {
+		return value
+	}
+}
+
+// Tap returns a function that calls `f` with its argument for its side effects,
+// and then returns the argument unchanged.
+func Tap[A any](f func(arg A)) func(arg A) A {
+	return func(arg A) A {
+		f(arg)
+		return arg
+	}
+}
@@ -0,0 +1,72 @@
+package funcs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+)
+
+func ExampleCurry3() {
+	join := func(sep string, a string, b string) string { return a + sep + b }
+	withDash := Curry3(join)("-")
+	fmt.Println(withDash("a")("b"))
+	fmt.Println(Uncurry3(Curry3(join))("+", "x", "y"))
+	// Output:
+	// a-b
+	// x+y
+}
+
+func ExamplePartial2() {
+	hasGoPrefix := Partial2(Flip(strings.HasPrefix), "go")
+	fmt.Println(hasGoPrefix("gopher"), hasGoPrefix("rust"))
+	// Output: true false
+}
+
+func ExampleFlow3() {
+	var seen []string
+	parse := Flow3(strings.TrimSpace, Tap(func(s string) { seen = append(seen, s) }), strings.ToUpper)
+	fmt.Println(parse("  hello "), seen)
+	fmt.Println(Pipe2(21, Times(2), strconv.Itoa))
+	fmt.Println(Const[string, int]("always")(42))
+	// Output:
+	// HELLO [hello]
+	// 42
+	// always
+}
+
+func ExampleComposeResult() {
+	parse := result.Wrap1(strconv.Atoi)
+	positive := func(x int) result.Result[int] {
+		if x <= 0 {
+			return result.Error[int](fmt.Errorf("%d is not positive", x))
+		}
+		return result.Ok(x)
+	}
+	parsePositive := ComposeResult(parse, positive)
+	fmt.Println(parsePositive("12").Extract())
+	fmt.Println(parsePositive("-3").Extract())
+	// Output:
+	// 12 <nil>
+	// 0 -3 is not positive
+}
+
+func ExampleComposeOption() {
+	lookup := func(key string) option.Optional[string] {
+		env := map[string]string{"PORT": "8080", "HOST": "localhost"}
+		return option.FromValueOrFalse(env[key], env[key] != "")
+	}
+	port := ComposeOption(lookup, func(s string) option.Optional[int] {
+		n, err := strconv.Atoi(s)
+		return option.FromValueOrFalse(n, err == nil)
+	})
+	fmt.Println(port("PORT").Value())
+	fmt.Println(port("HOST").Value())
+	fmt.Println(port("USER").Value())
+	// Output:
+	// 8080 true
+	// 0 false
+	// 0 false
+}
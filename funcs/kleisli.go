@@ -0,0 +1,38 @@
+package funcs
+
+import (
+	"github.com/cr7pt0gr4ph7/functional-go/eval"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/effects"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+)
+
+// The functions in this file implement Kleisli composition, i.e. the
+// equivalent of `Compose` for functions returning a monadic value:
+// the result of `f` is passed to `g` using the monad's `FlatMap`.
+//
+// `ComposeErr` is the equivalent for functions returning `(value, error)`.
+
+func ComposeOption[A any, B any, C any](f func(arg A) option.Optional[B], g func(arg B) option.Optional[C]) func(arg A) option.Optional[C] {
+	return func(arg A) option.Optional[C] {
+		return option.FlatMap(f(arg), g)
+	}
+}
+
+func ComposeResult[A any, B any, C any](f func(arg A) result.Result[B], g func(arg B) result.Result[C]) func(arg A) result.Result[C] {
+	return func(arg A) result.Result[C] {
+		return result.FlatMap(f(arg), g)
+	}
+}
+
+func ComposeEval[A any, B any, C any](f func(arg A) eval.Eval[B], g func(arg B) eval.Eval[C]) func(arg A) eval.Eval[C] {
+	return func(arg A) eval.Eval[C] {
+		return eval.FlatMap(f(arg), g)
+	}
+}
+
+func ComposeEff[E any, A any, B any, C any](f func(arg A) effects.Eff[E, B], g func(arg B) effects.Eff[E, C]) func(arg A) effects.Eff[E, C] {
+	return func(arg A) effects.Eff[E, C] {
+		return effects.FlatMap(f(arg), g)
+	}
+}
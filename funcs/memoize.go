@@ -0,0 +1,192 @@
+package funcs
+
+import (
+	"container/list"
+	"sync"
+	"time"
+
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+)
+
+// MemoConfig configures the cache of a `Memo`.
+//
+// The zero value caches all successful results forever.
+type MemoConfig struct {
+	// Maximum number of cached results. When exceeded,
+	// the least recently used result is evicted.
+	// Unbounded when zero or negative.
+	MaxEntries int
+	// Duration after which a cached result expires.
+	// Results never expire when zero or negative.
+	TTL time.Duration
+	// Returns the current time for computing expiry.
+	// Defaults to `time.Now` when nil.
+	Clock func() time.Time
+	// Whether to cache failures, i.e. panics raised by the memoized
+	// function and errors returned by functions passed to `MemoizeResult`.
+	// If set, later calls re-raise the panic or return the error
+	// instead of calling the function again.
+	CacheFailures bool
+}
+
+func (c MemoConfig) now() time.Time {
+	if c.Clock == nil {
+		return time.Now()
+	}
+	return c.Clock()
+}
+
+// Memo caches the results of a function by key.
+//
+// It is safe for concurrent use. Concurrent calls for the same key that is
+// not yet cached are collapsed into a single call of the function, whose
+// result (or panic) is shared by all callers. The function must not call
+// `Get` for the same key recursively, as this would deadlock.
+type Memo[K comparable, V any] struct {
+	f       func(key K) V
+	failed  func(value V) bool
+	config  MemoConfig
+	mu      sync.Mutex
+	entries map[K]*list.Element // values are *memoEntry[K, V]
+	lru     *list.List          // most recently used first
+	calls   map[K]*memoCall[V]
+}
+
+type memoOutcome[V any] struct {
+	value      V
+	panicked   bool
+	panicValue any
+}
+
+func (o memoOutcome[V]) get() V {
+	if o.panicked {
+		panic(o.panicValue)
+	}
+	return o.value
+}
+
+type memoEntry[K comparable, V any] struct {
+	key     K
+	outcome memoOutcome[V]
+	expires time.Time
+}
+
+type memoCall[V any] struct {
+	done    chan struct{}
+	outcome memoOutcome[V]
+}
+
+// NewMemo returns a Memo for `f`.
+func NewMemo[K comparable, V any](f func(key K) V, config MemoConfig) *Memo[K, V] {
+	return &Memo[K, V]{
+		f:       f,
+		failed:  func(_ V) bool { return false },
+		config:  config,
+		entries: make(map[K]*list.Element),
+		lru:     list.New(),
+		calls:   make(map[K]*memoCall[V]),
+	}
+}
+
+// Memoize returns a function that caches the results of `f` by key.
+// Panics are propagated to all waiting callers, but not cached unless
+// `config.CacheFailures` is set.
+//
+// See `Memo` for details.
+func Memoize[K comparable, V any](f func(key K) V, config MemoConfig) func(key K) V {
+	return NewMemo(f, config).Get
+}
+
+// MemoizeResult is like `Memoize`, but additionally does not cache
+// error results unless `config.CacheFailures` is set.
+func MemoizeResult[K comparable, V any](f func(key K) result.Result[V], config MemoConfig) func(key K) result.Result[V] {
+	m := NewMemo(f, config)
+	m.failed = result.Result[V].IsError
+	return m.Get
+}
+
+// Get returns the cached result for `key`, calling the function if necessary.
+func (m *Memo[K, V]) Get(key K) V {
+	m.mu.Lock()
+	if elem, ok := m.entries[key]; ok {
+		entry := elem.Value.(*memoEntry[K, V])
+		if m.config.TTL <= 0 || m.config.now().Before(entry.expires) {
+			m.lru.MoveToFront(elem)
+			m.mu.Unlock()
+			return entry.outcome.get()
+		}
+		m.remove(elem)
+	}
+	if call, ok := m.calls[key]; ok {
+		m.mu.Unlock()
+		<-call.done
+		return call.outcome.get()
+	}
+	call := &memoCall[V]{done: make(chan struct{})}
+	m.calls[key] = call
+	m.mu.Unlock()
+
+	call.outcome = m.call(key)
+
+	m.mu.Lock()
+	delete(m.calls, key)
+	failed := call.outcome.panicked || m.failed(call.outcome.value)
+	if !failed || m.config.CacheFailures {
+		m.insert(key, call.outcome)
+	}
+	m.mu.Unlock()
+	close(call.done)
+
+	return call.outcome.get()
+}
+
+// call invokes the function and captures its result or panic.
+func (m *Memo[K, V]) call(key K) (outcome memoOutcome[V]) {
+	defer func() {
+		if r := recover(); r != nil {
+			outcome = memoOutcome[V]{panicked: true, panicValue: r}
+		}
+	}()
+	return memoOutcome[V]{value: m.f(key)}
+}
+
+func (m *Memo[K, V]) insert(key K, outcome memoOutcome[V]) {
+	entry := &memoEntry[K, V]{key: key, outcome: outcome}
+	if m.config.TTL > 0 {
+		entry.expires = m.config.now().Add(m.config.TTL)
+	}
+	m.entries[key] = m.lru.PushFront(entry)
+	if m.config.MaxEntries > 0 && m.lru.Len() > m.config.MaxEntries {
+		m.remove(m.lru.Back())
+	}
+}
+
+func (m *Memo[K, V]) remove(elem *list.Element) {
+	m.lru.Remove(elem)
+	delete(m.entries, elem.Value.(*memoEntry[K, V]).key)
+}
+
+// Forget removes the cached result for `key`, if any.
+func (m *Memo[K, V]) Forget(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if elem, ok := m.entries[key]; ok {
+		m.remove(elem)
+	}
+}
+
+// Clear removes all cached results.
+func (m *Memo[K, V]) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.entries = make(map[K]*list.Element)
+	m.lru.Init()
+}
+
+// Len returns the number of cached results, including expired ones
+// that have not been evicted yet.
+func (m *Memo[K, V]) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.lru.Len()
+}
@@ -0,0 +1,181 @@
+package funcs
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+)
+
+func ExampleMemoize() {
+	calls := 0
+	square := Memoize(func(n int) int {
+		calls++
+		return n * n
+	}, MemoConfig{})
+	fmt.Println(square(3), square(3), square(4), calls)
+	// Output: 9 9 16 2
+}
+
+func ExampleMemoizeResult() {
+	calls := 0
+	parse := MemoizeResult(func(s string) result.Result[int] {
+		calls++
+		if s == "" {
+			return result.Error[int](errors.New("empty"))
+		}
+		return result.Ok(len(s))
+	}, MemoConfig{})
+	parse("")
+	parse("")
+	parse("abc")
+	parse("abc")
+	fmt.Println(calls)
+	// Output: 3
+}
+
+func TestMemoizeSingleflight(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	f := Memoize(func(n int) int {
+		calls.Add(1)
+		<-release
+		return n + 1
+	}, MemoConfig{})
+
+	const callers = 50
+	var started, wg sync.WaitGroup
+	results := make([]int, callers)
+	started.Add(callers)
+	wg.Add(callers)
+	for i := range callers {
+		go func() {
+			defer wg.Done()
+			started.Done()
+			results[i] = f(1)
+		}()
+	}
+	started.Wait()
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if n := calls.Load(); n != 1 {
+		t.Errorf("expected 1 call, got %d", n)
+	}
+	for i, r := range results {
+		if r != 2 {
+			t.Errorf("caller %d: expected 2, got %d", i, r)
+		}
+	}
+}
+
+func TestMemoizeLRU(t *testing.T) {
+	var calls []int
+	m := NewMemo(func(n int) int {
+		calls = append(calls, n)
+		return n
+	}, MemoConfig{MaxEntries: 2})
+
+	m.Get(1)
+	m.Get(2)
+	m.Get(1) // 2 is now least recently used
+	m.Get(3) // evicts 2
+	m.Get(1)
+	m.Get(2)
+
+	if fmt.Sprint(calls) != "[1 2 3 2]" {
+		t.Errorf("unexpected calls: %v", calls)
+	}
+	if m.Len() != 2 {
+		t.Errorf("expected 2 entries, got %d", m.Len())
+	}
+}
+
+func TestMemoizeTTL(t *testing.T) {
+	now := time.Unix(0, 0)
+	calls := 0
+	f := Memoize(func(n int) int {
+		calls++
+		return n
+	}, MemoConfig{TTL: time.Minute, Clock: func() time.Time { return now }})
+
+	f(1)
+	now = now.Add(59 * time.Second)
+	f(1)
+	if calls != 1 {
+		t.Errorf("expected 1 call before expiry, got %d", calls)
+	}
+	now = now.Add(time.Second)
+	f(1)
+	if calls != 2 {
+		t.Errorf("expected 2 calls after expiry, got %d", calls)
+	}
+}
+
+func TestMemoizePanics(t *testing.T) {
+	for _, cache := range []bool{false, true} {
+		calls := 0
+		f := Memoize(func(n int) int {
+			calls++
+			panic("boom")
+		}, MemoConfig{CacheFailures: cache})
+
+		for range 2 {
+			func() {
+				defer func() {
+					if r := recover(); r != "boom" {
+						t.Errorf("expected panic boom, got %v", r)
+					}
+				}()
+				f(1)
+			}()
+		}
+
+		want := 2
+		if cache {
+			want = 1
+		}
+		if calls != want {
+			t.Errorf("CacheFailures=%v: expected %d calls, got %d", cache, want, calls)
+		}
+	}
+}
+
+func TestMemoizeResultCacheFailures(t *testing.T) {
+	calls := 0
+	f := MemoizeResult(func(n int) result.Result[int] {
+		calls++
+		return result.Error[int](errors.New("failed"))
+	}, MemoConfig{CacheFailures: true})
+
+	f(1)
+	if r := f(1); r.IsOk() || calls != 1 {
+		t.Errorf("expected cached error after 1 call, got %v after %d calls", r, calls)
+	}
+}
+
+func TestMemoForgetAndClear(t *testing.T) {
+	calls := 0
+	m := NewMemo(func(n int) int {
+		calls++
+		return n
+	}, MemoConfig{})
+
+	m.Get(1)
+	m.Get(2)
+	m.Forget(1)
+	m.Get(1)
+	m.Get(2)
+	if calls != 3 {
+		t.Errorf("expected 3 calls after Forget, got %d", calls)
+	}
+	m.Clear()
+	if m.Len() != 0 {
+		t.Errorf("expected empty cache after Clear, got %d entries", m.Len())
+	}
+}
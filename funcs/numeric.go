@@ -0,0 +1,68 @@
+package funcs
+
+import (
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+)
+
+// Num is a dictionary of the arithmetic operations on T.
+//
+// It allows numeric types that do not satisfy `Numeric`,
+// like big or fixed-point numbers, to be used generically.
+type Num[T any] interface {
+	Zero() T
+	One() T
+	Add(x T, y T) T
+	Sub(x T, y T) T
+	Mul(x T, y T) T
+	Negate(x T) T
+}
+
+// Fractional extends Num with division.
+type Fractional[T any] interface {
+	Num[T]
+	// Div returns `x / y`, or an error if `y` is zero
+	// or the quotient cannot be represented.
+	Div(x T, y T) result.Result[T]
+}
+
+// NativeNum returns the Num instance for the built-in numeric type T.
+func NativeNum[T Numeric]() Num[T] {
+	return nativeNum[T]{}
+}
+
+type nativeNum[T Numeric] struct{}
+
+func (_ nativeNum[T]) Zero() T        { return 0 }
+func (_ nativeNum[T]) One() T         { return 1 }
+func (_ nativeNum[T]) Add(x T, y T) T { return x + y }
+func (_ nativeNum[T]) Sub(x T, y T) T { return x - y }
+func (_ nativeNum[T]) Mul(x T, y T) T { return x * y }
+func (_ nativeNum[T]) Negate(x T) T   { return -x }
+
+// PlusWith is like `Plus`, but uses the operations of `num`.
+func PlusWith[A any](num Num[A], summand A) func(in A) A {
+	return func(in A) A {
+		return num.Add(in, summand)
+	}
+}
+
+// MinusWith returns a function that subtracts `subtrahend` using the operations of `num`.
+func MinusWith[A any](num Num[A], subtrahend A) func(in A) A {
+	return func(in A) A {
+		return num.Sub(in, subtrahend)
+	}
+}
+
+// TimesWith is like `Times`, but uses the operations of `num`.
+func TimesWith[A any](num Num[A], factor A) func(in A) A {
+	return func(in A) A {
+		return num.Mul(in, factor)
+	}
+}
+
+// DividedByWith is like `DividedBy`, but uses the operations of `num`.
+func DividedByWith[A any](num Fractional[A], quotient A) func(in A) result.Result[A] {
+	return func(in A) result.Result[A] {
+		return num.Div(in, quotient)
+	}
+}
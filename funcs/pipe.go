@@ -0,0 +1,97 @@
+package funcs
+
+// Pipe1..Pipe9 pass a value through a sequence of functions, from left to right:
+// `Pipe3(a, f, g, h)` is equivalent to `h(g(f(a)))`.
+//
+// Flow1..Flow9 compose a sequence of functions from left to right:
+// `Flow3(f, g, h)` is equivalent to `func(a A) D { return h(g(f(a))) }`.
+
+func Pipe1[A any, B any](a A, f1 func(a A) B) B {
+	return f1(a)
+}
+
+func Flow1[A any, B any](f1 func(a A) B) func(a A) B {
+	return func(a A) B {
+		return f1(a)
+	}
+}
+
+func Pipe2[A any, B any, C any](a A, f1 func(a A) B, f2 func(b B) C) C {
+	return f2(f1(a))
+}
+
+func Flow2[A any, B any, C any](f1 func(a A) B, f2 func(b B) C) func(a A) C {
+	return func(a A) C {
+		return f2(f1(a))
+	}
+}
+
+func Pipe3[A any, B any, C any, D any](a A, f1 func(a A) B, f2 func(b B) C, f3 func(c C) D) D {
+	return f3(f2(f1(a)))
+}
+
+func Flow3[A any, B any, C any, D any](f1 func(a A) B, f2 func(b B) C, f3 func(c C) D) func(a A) D {
+	return func(a A) D {
+		return f3(f2(f1(a)))
+	}
+}
+
+func Pipe4[A any, B any, C any, D any, E any](a A, f1 func(a A) B, f2 func(b B) C, f3 func(c C) D, f4 func(d D) E) E {
+	return f4(f3(f2(f1(a))))
+}
+
+func Flow4[A any, B any, C any, D any, E any](f1 func(a A) B, f2 func(b B) C, f3 func(c C) D, f4 func(d D) E) func(a A) E {
+	return func(a A) E {
+		return f4(f3(f2(f1(a))))
+	}
+}
+
+func Pipe5[A any, B any, C any, D any, E any, F any](a A, f1 func(a A) B, f2 func(b B) C, f3 func(c C) D, f4 func(d D) E, f5 func(e E) F) F {
+	return f5(f4(f3(f2(f1(a)))))
+}
+
+func Flow5[A any, B any, C any, D any, E any, F any](f1 func(a A) B, f2 func(b B) C, f3 func(c C) D, f4 func(d D) E, f5 func(e E) F) func(a A) F {
+	return func(a A) F {
+		return f5(f4(f3(f2(f1(a)))))
+	}
+}
+
+func Pipe6[A any, B any, C any, D any, E any, F any, G any](a A, f1 func(a A) B, f2 func(b B) C, f3 func(c C) D, f4 func(d D) E, f5 func(e E) F, f6 func(f F) G) G {
+	return f6(f5(f4(f3(f2(f1(a))))))
+}
+
+func Flow6[A any, B any, C any, D any, E any, F any, G any](f1 func(a A) B, f2 func(b B) C, f3 func(c C) D, f4 func(d D) E, f5 func(e E) F, f6 func(f F) G) func(a A) G {
+	return func(a A) G {
+		return f6(f5(f4(f3(f2(f1(a))))))
+	}
+}
+
+func Pipe7[A any, B any, C any, D any, E any, F any, G any, H any](a A, f1 func(a A) B, f2 func(b B) C, f3 func(c C) D, f4 func(d D) E, f5 func(e E) F, f6 func(f F) G, f7 func(g G) H) H {
+	return f7(f6(f5(f4(f3(f2(f1(a)))))))
+}
+
+func Flow7[A any, B any, C any, D any, E any, F any, G any, H any](f1 func(a A) B, f2 func(b B) C, f3 func(c C) D, f4 func(d D) E, f5 func(e E) F, f6 func(f F) G, f7 func(g G) H) func(a A) H {
+	return func(a A) H {
+		return f7(f6(f5(f4(f3(f2(f1(a)))))))
+	}
+}
+
+func Pipe8[A any, B any, C any, D any, E any, F any, G any, H any, I any](a A, f1 func(a A) B, f2 func(b B) C, f3 func(c C) D, f4 func(d D) E, f5 func(e E) F, f6 func(f F) G, f7 func(g G) H, f8 func(h H) I) I {
+	return f8(f7(f6(f5(f4(f3(f2(f1(a))))))))
+}
+
+func Flow8[A any, B any, C any, D any, E any, F any, G any, H any, I any](f1 func(a A) B, f2 func(b B) C, f3 func(c C) D, f4 func(d D) E, f5 func(e E) F, f6 func(f F) G, f7 func(g G) H, f8 func(h H) I) func(a A) I {
+	return func(a A) I {
+		return f8(f7(f6(f5(f4(f3(f2(f1(a))))))))
+	}
+}
+
+func Pipe9[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any](a A, f1 func(a A) B, f2 func(b B) C, f3 func(c C) D, f4 func(d D) E, f5 func(e E) F, f6 func(f F) G, f7 func(g G) H, f8 func(h H) I, f9 func(i I) J) J {
+	return f9(f8(f7(f6(f5(f4(f3(f2(f1(a)))))))))
+}
+
+func Flow9[A any, B any, C any, D any, E any, F any, G any, H any, I any, J any](f1 func(a A) B, f2 func(b B) C, f3 func(c C) D, f4 func(d D) E, f5 func(e E) F, f6 func(f F) G, f7 func(g G) H, f8 func(h H) I, f9 func(i I) J) func(a A) J {
+	return func(a A) J {
+		return f9(f8(f7(f6(f5(f4(f3(f2(f1(a)))))))))
+	}
+}
@@ -0,0 +1,236 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/cr7pt0gr4ph7/functional-go/eval"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+	"github.com/cr7pt0gr4ph7/functional-go/rx"
+	"github.com/cr7pt0gr4ph7/functional-go/rx/subscriptions"
+	"github.com/cr7pt0gr4ph7/functional-go/tuple"
+)
+
+var (
+	// ErrTimeout is the error of a Future returned by `Timeout`
+	// that was not completed in time.
+	ErrTimeout = errors.New("future: timeout")
+	// ErrNoFutures is the error of a Future returned by `Any`
+	// or `Race` that was called without any Futures.
+	ErrNoFutures = errors.New("future: no futures given")
+)
+
+// ===============
+// :: Transform ::
+// ===============
+
+// Map applies `fn` to the value of `f`, if it succeeds.
+func Map[A any, B any](f Future[A], fn func(a A) B) Future[B] {
+	return FlatMap(f, func(a A) Future[B] {
+		return Resolved(fn(a))
+	})
+}
+
+// FlatMap continues with the Future returned by `fn` for the value of `f`, if it succeeds.
+func FlatMap[A any, B any](f Future[A], fn func(a A) Future[B]) Future[B] {
+	p := NewPromise[B]()
+	f.OnComplete(func(r result.Result[A]) {
+		a, err := r.Extract()
+		if err != nil {
+			p.Reject(err)
+			return
+		}
+		next, err := catchPanic(func() Future[B] {
+			return fn(a)
+		})
+		if err != nil {
+			p.Reject(err)
+			return
+		}
+		next.OnComplete(func(r result.Result[B]) {
+			p.Complete(r)
+		})
+	})
+	return p.Future()
+}
+
+// catchPanic calls `f`, and returns an `*eval.PanicError` if it panics,
+// like the constructors of `eval.EvalE` do.
+func catchPanic[T any](f func() T) (value T, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = &eval.PanicError{Value: p, Stack: debug.Stack()}
+		}
+	}()
+	return f(), nil
+}
+
+// WithContext returns a Future that is completed like `f`, or rejected
+// with `ctx.Err()` if `ctx` is done before `f` is completed.
+func WithContext[T any](ctx context.Context, f Future[T]) Future[T] {
+	if ctx.Done() == nil {
+		return f
+	}
+	p := NewPromise[T]()
+	stop := context.AfterFunc(ctx, func() {
+		p.Reject(ctx.Err())
+	})
+	f.OnComplete(func(r result.Result[T]) {
+		stop()
+		p.Complete(r)
+	})
+	return p.Future()
+}
+
+// Timeout returns a Future that is completed like `f`,
+// or rejected with `ErrTimeout` if `f` is not completed within `d`.
+func Timeout[T any](f Future[T], d time.Duration) Future[T] {
+	p := NewPromise[T]()
+	timer := time.AfterFunc(d, func() {
+		p.Reject(ErrTimeout)
+	})
+	f.OnComplete(func(r result.Result[T]) {
+		timer.Stop()
+		p.Complete(r)
+	})
+	return p.Future()
+}
+
+// =============
+// :: Combine ::
+// =============
+
+// Zip returns a Future of the values of `fa` and `fb`.
+// It is rejected as soon as one of them fails.
+func Zip[A any, B any](fa Future[A], fb Future[B]) Future[tuple.Pair[A, B]] {
+	p := NewPromise[tuple.Pair[A, B]]()
+	var pair tuple.Pair[A, B]
+	var remaining atomic.Int32
+	remaining.Store(2)
+	fa.OnComplete(func(r result.Result[A]) {
+		a, err := r.Extract()
+		if err != nil {
+			p.Reject(err)
+			return
+		}
+		pair.First = a
+		if remaining.Add(-1) == 0 {
+			p.Resolve(pair)
+		}
+	})
+	fb.OnComplete(func(r result.Result[B]) {
+		b, err := r.Extract()
+		if err != nil {
+			p.Reject(err)
+			return
+		}
+		pair.Second = b
+		if remaining.Add(-1) == 0 {
+			p.Resolve(pair)
+		}
+	})
+	return p.Future()
+}
+
+// All returns a Future of the values of `futures`, in the same order.
+// It is rejected as soon as one of them fails.
+func All[T any](futures ...Future[T]) Future[[]T] {
+	p := NewPromise[[]T]()
+	values := make([]T, len(futures))
+	var remaining atomic.Int64
+	remaining.Store(int64(len(futures)))
+	if len(futures) == 0 {
+		p.Resolve(values)
+	}
+	for i, f := range futures {
+		f.OnComplete(func(r result.Result[T]) {
+			v, err := r.Extract()
+			if err != nil {
+				p.Reject(err)
+				return
+			}
+			values[i] = v
+			if remaining.Add(-1) == 0 {
+				p.Resolve(values)
+			}
+		})
+	}
+	return p.Future()
+}
+
+// Any returns a Future of the value of the first of `futures` that succeeds.
+// If all of them fail, it is rejected with all of their errors.
+func Any[T any](futures ...Future[T]) Future[T] {
+	p := NewPromise[T]()
+	if len(futures) == 0 {
+		p.Reject(ErrNoFutures)
+	}
+	errs := make([]error, len(futures))
+	var remaining atomic.Int64
+	remaining.Store(int64(len(futures)))
+	for i, f := range futures {
+		f.OnComplete(func(r result.Result[T]) {
+			if r.IsOk() {
+				p.Complete(r)
+				return
+			}
+			errs[i] = r.Error()
+			if remaining.Add(-1) == 0 {
+				p.Reject(errors.Join(errs...))
+			}
+		})
+	}
+	return p.Future()
+}
+
+// Race returns a Future that is completed like the first of `futures`
+// to complete, whether it succeeds or fails.
+func Race[T any](futures ...Future[T]) Future[T] {
+	p := NewPromise[T]()
+	if len(futures) == 0 {
+		p.Reject(ErrNoFutures)
+	}
+	for _, f := range futures {
+		f.OnComplete(func(r result.Result[T]) {
+			p.Complete(r)
+		})
+	}
+	return p.Future()
+}
+
+// =================
+// :: Conversions ::
+// =================
+
+// ToObservable returns an Observable that emits the value of `f` and completes,
+// or fails with its error. Once `Cancel` on a subscription has returned, the
+// observer is not notified anymore. Cancelling blocks while the observer is
+// being notified, so it must not be called from the observer's callbacks.
+func ToObservable[T any](f Future[T]) rx.Observable[T] {
+	return rx.NewObservable(func(observer rx.Observer[T]) rx.Subscription {
+		var mu sync.Mutex
+		cancelled := false
+		f.OnComplete(func(r result.Result[T]) {
+			mu.Lock()
+			defer mu.Unlock()
+			if cancelled {
+				return
+			}
+			if v, err := r.Extract(); err != nil {
+				observer.Error(err)
+			} else {
+				observer.Next(v)
+				observer.Done()
+			}
+		})
+		return subscriptions.New(func() {
+			mu.Lock()
+			defer mu.Unlock()
+			cancelled = true
+		})
+	})
+}
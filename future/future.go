@@ -0,0 +1,194 @@
+// Package future provides `Future[T]`, a value that becomes available
+// asynchronously, and `Promise[T]`, which is used to complete a Future.
+//
+// A Future is completed exactly once, either with a value or with an error.
+// Its combinators do not start goroutines: they register callbacks that run
+// on the goroutine that completes the source Future. Panics raised by the
+// functions passed to them are captured as an `*eval.PanicError`.
+package future
+
+import (
+	"context"
+	"sync"
+
+	"github.com/cr7pt0gr4ph7/functional-go/eval"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+)
+
+// state is shared by a Promise and its Future.
+type state[T any] struct {
+	mu        sync.Mutex
+	done      chan struct{}
+	completed bool
+	result    result.Result[T]
+	callbacks []func(r result.Result[T])
+}
+
+func newState[T any]() *state[T] {
+	return &state[T]{done: make(chan struct{})}
+}
+
+func (s *state[T]) complete(r result.Result[T]) bool {
+	s.mu.Lock()
+	if s.completed {
+		s.mu.Unlock()
+		return false
+	}
+	s.completed, s.result = true, r
+	callbacks := s.callbacks
+	s.callbacks = nil
+	close(s.done)
+	s.mu.Unlock()
+
+	for _, callback := range callbacks {
+		callback(r)
+	}
+	return true
+}
+
+func (s *state[T]) onComplete(callback func(r result.Result[T])) {
+	s.mu.Lock()
+	if !s.completed {
+		s.callbacks = append(s.callbacks, callback)
+		s.mu.Unlock()
+		return
+	}
+	r := s.result
+	s.mu.Unlock()
+	callback(r)
+}
+
+// =============
+// :: Promise ::
+// =============
+
+// Promise is the writing side of a Future.
+// It is safe for concurrent use.
+type Promise[T any] struct {
+	s *state[T]
+}
+
+// NewPromise returns a new, incomplete Promise.
+func NewPromise[T any]() Promise[T] {
+	return Promise[T]{newState[T]()}
+}
+
+// Future returns the Future that is completed by `p`.
+func (p Promise[T]) Future() Future[T] {
+	return Future[T]{p.s}
+}
+
+// Complete completes the Future of `p` with `r`.
+// Returns false if it has already been completed.
+func (p Promise[T]) Complete(r result.Result[T]) bool {
+	return p.s.complete(r)
+}
+
+// Resolve completes the Future of `p` with `value`.
+// Returns false if it has already been completed.
+func (p Promise[T]) Resolve(value T) bool {
+	return p.Complete(result.Ok(value))
+}
+
+// Reject completes the Future of `p` with `err`.
+// Returns false if it has already been completed.
+func (p Promise[T]) Reject(err error) bool {
+	return p.Complete(result.Error[T](err))
+}
+
+// ============
+// :: Future ::
+// ============
+
+// Future is the reading side of a Promise.
+// The zero value is not a valid Future; obtain one from `Promise.Future`
+// or from the other constructors in this package.
+type Future[T any] struct {
+	s *state[T]
+}
+
+// Resolved returns a Future that is completed with `value`.
+func Resolved[T any](value T) Future[T] {
+	return Completed(result.Ok(value))
+}
+
+// Rejected returns a Future that is completed with `err`.
+func Rejected[T any](err error) Future[T] {
+	return Completed(result.Error[T](err))
+}
+
+// Completed returns a Future that is completed with `r`.
+func Completed[T any](r result.Result[T]) Future[T] {
+	p := NewPromise[T]()
+	p.Complete(r)
+	return p.Future()
+}
+
+// Go runs `f` on a new goroutine and returns a Future of its result.
+//
+// The Future is rejected with `ctx.Err()` as soon as `ctx` is done,
+// even if `f` is still running; `f` should observe `ctx` to stop early.
+// This also applies if `f` returns after `ctx` is done.
+func Go[T any](ctx context.Context, f func(ctx context.Context) (T, error)) Future[T] {
+	return WithContext(ctx, run(eval.AlwaysE(func() (T, error) {
+		v, err := f(ctx)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return v, ctxErr
+		}
+		return v, err
+	})))
+}
+
+// FromEval evaluates `e` on a new goroutine and returns a Future of its value.
+//
+// Like with `Go`, the Future is rejected with `ctx.Err()` as soon as `ctx` is done.
+func FromEval[T any](ctx context.Context, e eval.Eval[T]) Future[T] {
+	return WithContext(ctx, run(eval.Attempt(e)))
+}
+
+// FromEvalE is like `FromEval`, but completes the Future with the error of `e` if it fails.
+func FromEvalE[T any](ctx context.Context, e eval.EvalE[T]) Future[T] {
+	return WithContext(ctx, run(e))
+}
+
+func run[T any](e eval.EvalE[T]) Future[T] {
+	p := NewPromise[T]()
+	go func() {
+		p.Complete(e.ToResult())
+	}()
+	return p.Future()
+}
+
+// Done returns a channel that is closed when `f` is completed.
+func (f Future[T]) Done() <-chan struct{} {
+	return f.s.done
+}
+
+// TryGet returns the result of `f`, or false if it is not completed yet.
+func (f Future[T]) TryGet() (result.Result[T], bool) {
+	f.s.mu.Lock()
+	defer f.s.mu.Unlock()
+	return f.s.result, f.s.completed
+}
+
+// Await blocks until `f` is completed and returns its result,
+// or returns `ctx.Err()` if `ctx` is done first.
+func (f Future[T]) Await(ctx context.Context) result.Result[T] {
+	select {
+	case <-f.s.done:
+	default:
+		select {
+		case <-f.s.done:
+		case <-ctx.Done():
+			return result.Error[T](ctx.Err())
+		}
+	}
+	r, _ := f.TryGet()
+	return r
+}
+
+// OnComplete calls `callback` with the result of `f` once it is completed.
+// If `f` is already completed, `callback` is called immediately.
+func (f Future[T]) OnComplete(callback func(r result.Result[T])) {
+	f.s.onComplete(callback)
+}
@@ -0,0 +1,191 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cr7pt0gr4ph7/functional-go/eval"
+	"github.com/cr7pt0gr4ph7/functional-go/rx"
+)
+
+func ExampleFuture() {
+	ctx := context.Background()
+	p := NewPromise[int]()
+	doubled := Map(p.Future(), func(x int) int { return 2 * x })
+	go p.Resolve(21)
+	fmt.Println(doubled.Await(ctx).Extract())
+	// Output: 42 <nil>
+}
+
+func ExampleAll() {
+	ctx := context.Background()
+	squares := make([]Future[int], 5)
+	for i := range squares {
+		squares[i] = Go(ctx, func(ctx context.Context) (int, error) {
+			return i * i, nil
+		})
+	}
+	fmt.Println(All(squares...).Await(ctx).MustBeValue())
+	// Output: [0 1 4 9 16]
+}
+
+func ExampleFromEval() {
+	ctx := context.Background()
+	e := eval.Later(func() string { return "computed" })
+	fmt.Println(FromEval(ctx, e).Await(ctx).MustBeValue())
+	// Output: computed
+}
+
+func ExampleToObservable() {
+	done := make(chan struct{})
+	ToObservable(Resolved("hello")).Subscribe(rx.NewObserver(rx.AnonymousObserverConfig[string]{
+		Next:  func(value string) { fmt.Println("next:", value) },
+		Done:  func() { fmt.Println("done"); close(done) },
+		Error: func(err error) { fmt.Println("error:", err) },
+	}))
+	<-done
+	// Output:
+	// next: hello
+	// done
+}
+
+func TestPromiseCompletesOnce(t *testing.T) {
+	p := NewPromise[int]()
+	if !p.Resolve(1) || p.Resolve(2) || p.Reject(errors.New("late")) {
+		t.Error("only the first completion should succeed")
+	}
+	if r, ok := p.Future().TryGet(); !ok || r.MustBeValue() != 1 {
+		t.Errorf("expected 1, got %v", r)
+	}
+}
+
+func TestAwaitContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := NewPromise[int]().Future().Await(ctx).Error(); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	// A completed Future wins over a done context.
+	if r := Resolved(1).Await(ctx); !r.IsOk() {
+		t.Errorf("expected the value, got %v", r.Error())
+	}
+}
+
+func TestGoCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	f := Go(ctx, func(ctx context.Context) (int, error) {
+		<-ctx.Done()
+		return 0, nil
+	})
+	cancel()
+	if err := f.Await(context.Background()).Error(); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestGoCapturesPanics(t *testing.T) {
+	f := Go(context.Background(), func(ctx context.Context) (int, error) {
+		panic("boom")
+	})
+	var p *eval.PanicError
+	if err := f.Await(context.Background()).Error(); !errors.As(err, &p) || p.Value != "boom" {
+		t.Errorf("expected a PanicError, got %v", err)
+	}
+	g := FlatMap(Resolved(1), func(int) Future[int] { panic("boom") })
+	if err := g.Await(context.Background()).Error(); !errors.As(err, &p) {
+		t.Errorf("expected a PanicError, got %v", err)
+	}
+}
+
+func TestZip(t *testing.T) {
+	ctx := context.Background()
+	pair := Zip(Resolved(1), Resolved("one")).Await(ctx).MustBeValue()
+	if pair.First != 1 || pair.Second != "one" {
+		t.Errorf("unexpected pair %v", pair)
+	}
+	failed := errors.New("failed")
+	if err := Zip(NewPromise[int]().Future(), Rejected[int](failed)).Await(ctx).Error(); err != failed {
+		t.Errorf("expected %v, got %v", failed, err)
+	}
+}
+
+func TestAllFailsFast(t *testing.T) {
+	failed := errors.New("failed")
+	f := All(NewPromise[int]().Future(), Rejected[int](failed))
+	if err := f.Await(context.Background()).Error(); err != failed {
+		t.Errorf("expected %v, got %v", failed, err)
+	}
+}
+
+func TestAny(t *testing.T) {
+	ctx := context.Background()
+	e1, e2 := errors.New("e1"), errors.New("e2")
+	if v := Any(Rejected[int](e1), Resolved(2), NewPromise[int]().Future()).Await(ctx).MustBeValue(); v != 2 {
+		t.Errorf("expected 2, got %d", v)
+	}
+	err := Any(Rejected[int](e1), Rejected[int](e2)).Await(ctx).Error()
+	if !errors.Is(err, e1) || !errors.Is(err, e2) {
+		t.Errorf("expected both errors, got %v", err)
+	}
+	if err := Any[int]().Await(ctx).Error(); err != ErrNoFutures {
+		t.Errorf("expected ErrNoFutures, got %v", err)
+	}
+}
+
+func TestRace(t *testing.T) {
+	failed := errors.New("failed")
+	p := NewPromise[int]()
+	f := Race(p.Future(), NewPromise[int]().Future())
+	p.Reject(failed)
+	if err := f.Await(context.Background()).Error(); err != failed {
+		t.Errorf("expected %v, got %v", failed, err)
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	ctx := context.Background()
+	if err := Timeout(NewPromise[int]().Future(), time.Millisecond).Await(ctx).Error(); err != ErrTimeout {
+		t.Errorf("expected ErrTimeout, got %v", err)
+	}
+	if v := Timeout(Resolved(1), time.Hour).Await(ctx).MustBeValue(); v != 1 {
+		t.Errorf("expected 1, got %d", v)
+	}
+}
+
+func TestToObservableCancel(t *testing.T) {
+	p := NewPromise[int]()
+	notified := false
+	s := ToObservable(p.Future()).Subscribe(rx.NewObserver(rx.AnonymousObserverConfig[int]{
+		Next:  func(int) { notified = true },
+		Done:  func() { notified = true },
+		Error: func(error) { notified = true },
+	}))
+	s.Cancel()
+	p.Resolve(1)
+	if notified {
+		t.Error("observer should not be notified after cancellation")
+	}
+}
+
+func TestToObservableCancelConcurrently(t *testing.T) {
+	for range 100 {
+		p := NewPromise[int]()
+		var cancelled, late atomic.Bool
+		s := ToObservable(p.Future()).Subscribe(rx.NewObserver(rx.AnonymousObserverConfig[int]{
+			Next:  func(int) { late.Store(late.Load() || cancelled.Load()) },
+			Done:  func() { late.Store(late.Load() || cancelled.Load()) },
+			Error: func(error) { late.Store(true) },
+		}))
+		go p.Resolve(1)
+		s.Cancel()
+		cancelled.Store(true)
+		<-p.Future().Done()
+		if late.Load() {
+			t.Fatal("observer was notified after Cancel returned")
+		}
+	}
+}
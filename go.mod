@@ -1,5 +1,5 @@
 module github.com/cr7pt0gr4ph7/functional-go
 
-go 1.18
+go 1.23
 
 require golang.org/x/exp v0.0.0-20220310221936-9d5fb453b98c
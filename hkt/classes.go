@@ -0,0 +1,72 @@
+package hkt
+
+// Functor is the dictionary for type constructors F that support `Map`.
+type Functor[F any] interface {
+	// MapAny is the type-erased implementation of `Map`.
+	MapAny(fa Kind[F, any], f func(a any) any) Kind[F, any]
+}
+
+// Applicative is the dictionary for type constructors F that support
+// `Pure` and `Map2`, i.e. the combination of independent computations.
+type Applicative[F any] interface {
+	Functor[F]
+	// PureAny is the type-erased implementation of `Pure`.
+	PureAny(a any) Kind[F, any]
+	// Map2Any is the type-erased implementation of `Map2`.
+	Map2Any(fa Kind[F, any], fb Kind[F, any], f func(a any, b any) any) Kind[F, any]
+}
+
+// Monad is the dictionary for type constructors F that support `FlatMap`,
+// i.e. the sequencing of dependent computations.
+type Monad[F any] interface {
+	Applicative[F]
+	// FlatMapAny is the type-erased implementation of `FlatMap`.
+	FlatMapAny(fa Kind[F, any], f func(a any) Kind[F, any]) Kind[F, any]
+}
+
+// Map applies `f` to the value(s) inside `fa`.
+func Map[F any, A any, B any](m Functor[F], fa Kind[F, A], f func(a A) B) Kind[F, B] {
+	return Unerase[F, B](m.MapAny(Erase(fa), func(a any) any {
+		return f(Cast[A](a))
+	}))
+}
+
+// Pure lifts `a` into F.
+func Pure[F any, A any](m Applicative[F], a A) Kind[F, A] {
+	return Unerase[F, A](m.PureAny(a))
+}
+
+// Map2 combines the values inside `fa` and `fb` using `f`.
+func Map2[F any, A any, B any, C any](m Applicative[F], fa Kind[F, A], fb Kind[F, B], f func(a A, b B) C) Kind[F, C] {
+	return Unerase[F, C](m.Map2Any(Erase(fa), Erase(fb), func(a any, b any) any {
+		return f(Cast[A](a), Cast[B](b))
+	}))
+}
+
+// Ap applies the function(s) inside `ff` to the value(s) inside `fa`.
+func Ap[F any, A any, B any](m Applicative[F], ff Kind[F, func(a A) B], fa Kind[F, A]) Kind[F, B] {
+	return Map2(m, ff, fa, func(f func(a A) B, a A) B {
+		return f(a)
+	})
+}
+
+// FlatMap applies `f` to the value(s) inside `fa` and flattens the result.
+func FlatMap[F any, A any, B any](m Monad[F], fa Kind[F, A], f func(a A) Kind[F, B]) Kind[F, B] {
+	return Unerase[F, B](m.FlatMapAny(Erase(fa), func(a any) Kind[F, any] {
+		return Erase(f(Cast[A](a)))
+	}))
+}
+
+// Flatten removes one level of nesting from `ffa`.
+func Flatten[F any, A any](m Monad[F], ffa Kind[F, Kind[F, A]]) Kind[F, A] {
+	return FlatMap(m, ffa, func(fa Kind[F, A]) Kind[F, A] {
+		return fa
+	})
+}
+
+// Void discards the value(s) inside `fa`, but keeps its effects.
+func Void[F any, A any](m Functor[F], fa Kind[F, A]) Kind[F, Unit] {
+	return Map(m, fa, func(_ A) Unit {
+		return Unit{}
+	})
+}
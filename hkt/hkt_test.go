@@ -0,0 +1,132 @@
+package hkt
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/cr7pt0gr4ph7/functional-go/eval"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/identity"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+)
+
+// sumAll is written once and works for every monad.
+func sumAll[F any](m Monad[F], xs []Kind[F, int]) Kind[F, int] {
+	return Map(m, Sequence(m, xs), func(xs []int) int {
+		sum := 0
+		for _, x := range xs {
+			sum += x
+		}
+		return sum
+	})
+}
+
+func ExampleSequence() {
+	fmt.Println(ToOptional(sumAll(OptionMonad(), []Kind[OptionF, int]{
+		FromOptional(option.Some(1)),
+		FromOptional(option.Some(2)),
+	})).Value())
+	fmt.Println(ToOptional(sumAll(OptionMonad(), []Kind[OptionF, int]{
+		FromOptional(option.Some(1)),
+		FromOptional(option.None[int]()),
+	})).Value())
+	fmt.Println(ToEval(sumAll(EvalMonad(), []Kind[EvalF, int]{
+		FromEval(eval.Now(3)),
+		FromEval(eval.Later(func() int { return 4 })),
+	})).Value())
+	fmt.Println(ToIdentity(sumAll(IdentityMonad(), []Kind[IdentityF, int]{
+		FromIdentity(identity.Return(5)),
+		FromIdentity(identity.Return(6)),
+	})).Value)
+	// Output:
+	// 3 true
+	// 0 false
+	// 7
+	// 11
+}
+
+func ExampleForM() {
+	parse := func(s string) Kind[ResultF, int] {
+		return FromResult(result.From(strconv.Atoi(s)))
+	}
+	fmt.Println(ToResult(ForM(ResultMonad(), []string{"1", "2", "3"}, parse)).Extract())
+	_, err := ToResult(ForM(ResultMonad(), []string{"1", "x", "3"}, parse)).Extract()
+	fmt.Println(err != nil)
+	// Output:
+	// [1 2 3] <nil>
+	// true
+}
+
+func TestForMShortCircuits(t *testing.T) {
+	calls := 0
+	r := ForM(OptionMonad(), []int{1, 2, 3, 4}, func(x int) Kind[OptionF, int] {
+		calls++
+		return FromOptional(option.Filter(option.Some(x), func(x int) bool { return x < 2 }))
+	})
+	if ToOptional(r).IsPresent() {
+		t.Errorf("expected None, got %v", ToOptional(r))
+	}
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestWhen(t *testing.T) {
+	errFailed := errors.New("failed")
+	action := FromResult(result.Error[Unit](errFailed))
+	if err := ToResult(When(ResultMonad(), true, action)).Error(); err != errFailed {
+		t.Errorf("expected action to run, got %v", err)
+	}
+	if err := ToResult(When(ResultMonad(), false, action)).Error(); err != nil {
+		t.Errorf("expected no-op, got %v", err)
+	}
+	if err := ToResult(Unless(ResultMonad(), true, action)).Error(); err != nil {
+		t.Errorf("expected no-op, got %v", err)
+	}
+}
+
+func TestNilInterfaceValues(t *testing.T) {
+	var err error
+	r := ToOptional(Pure[OptionF](OptionMonad(), err))
+	if v, ok := r.Value(); !ok || v != nil {
+		t.Errorf("expected Some(nil), got %v", r)
+	}
+}
+
+func TestZeroKind(t *testing.T) {
+	var k Kind[OptionF, int]
+	if ToOptional(Map(OptionMonad(), k, func(x int) int { return x + 1 })).IsPresent() {
+		t.Errorf("expected the zero Kind to behave like None")
+	}
+}
+
+func TestForMReevaluation(t *testing.T) {
+	n := 0
+	next := func(x int) Kind[EvalF, int] {
+		return FromEval(eval.Always(func() int { n++; return 100*x + n }))
+	}
+	e := ToEval(ForM(EvalMonad(), []int{1, 2}, next))
+	first := e.Value()
+	second := e.Value()
+	if !slices.Equal(first, []int{101, 202}) || !slices.Equal(second, []int{103, 204}) {
+		t.Errorf("expected [101 202] and [103 204], got %v and %v", first, second)
+	}
+	traversed := ToEval(Traverse(EvalMonad(), []int{1, 2}, next))
+	first = traversed.Value()
+	traversed.Value()
+	if !slices.Equal(first, []int{105, 206}) {
+		t.Errorf("expected [105 206], got %v", first)
+	}
+}
+
+func TestWrongBrandPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("expected a panic")
+		}
+	}()
+	ToOptional(Wrap[OptionF, int](42))
+}
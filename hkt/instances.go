@@ -0,0 +1,286 @@
+package hkt
+
+import (
+	"github.com/cr7pt0gr4ph7/functional-go/eval"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/effects"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/either"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/identity"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+)
+
+// ============
+// :: Option ::
+// ============
+
+// OptionF is the brand for `option.Optional`.
+type OptionF struct{}
+
+// FromOptional encodes `o` as a Kind.
+func FromOptional[A any](o option.Optional[A]) Kind[OptionF, A] {
+	return Wrap[OptionF, A](option.Map(o, toAny[A]))
+}
+
+// ToOptional decodes `k` into an `option.Optional`.
+func ToOptional[A any](k Kind[OptionF, A]) option.Optional[A] {
+	return option.Map(unwrap[option.Optional[any]](Erase(k)), Cast[A])
+}
+
+// OptionMonad returns the Monad instance for `option.Optional`.
+func OptionMonad() Monad[OptionF] {
+	return optionMonad{}
+}
+
+type optionMonad struct{}
+
+func (_ optionMonad) MapAny(fa Kind[OptionF, any], f func(a any) any) Kind[OptionF, any] {
+	return Wrap[OptionF, any](option.Map(unwrap[option.Optional[any]](fa), f))
+}
+
+func (_ optionMonad) PureAny(a any) Kind[OptionF, any] {
+	return Wrap[OptionF, any](option.Some(a))
+}
+
+func (m optionMonad) Map2Any(fa Kind[OptionF, any], fb Kind[OptionF, any], f func(a any, b any) any) Kind[OptionF, any] {
+	return m.FlatMapAny(fa, func(a any) Kind[OptionF, any] {
+		return m.MapAny(fb, func(b any) any {
+			return f(a, b)
+		})
+	})
+}
+
+func (_ optionMonad) FlatMapAny(fa Kind[OptionF, any], f func(a any) Kind[OptionF, any]) Kind[OptionF, any] {
+	return Wrap[OptionF, any](option.FlatMap(unwrap[option.Optional[any]](fa), func(a any) option.Optional[any] {
+		return unwrap[option.Optional[any]](f(a))
+	}))
+}
+
+// ============
+// :: Result ::
+// ============
+
+// ResultF is the brand for `result.Result`.
+type ResultF struct{}
+
+// FromResult encodes `r` as a Kind.
+func FromResult[A any](r result.Result[A]) Kind[ResultF, A] {
+	return Wrap[ResultF, A](result.Map(r, toAny[A]))
+}
+
+// ToResult decodes `k` into a `result.Result`.
+func ToResult[A any](k Kind[ResultF, A]) result.Result[A] {
+	return result.Map(unwrap[result.Result[any]](Erase(k)), Cast[A])
+}
+
+// ResultMonad returns the Monad instance for `result.Result`.
+//
+// Like `result.FlatMap`, it stops at the first error.
+func ResultMonad() Monad[ResultF] {
+	return resultMonad{}
+}
+
+type resultMonad struct{}
+
+func (_ resultMonad) MapAny(fa Kind[ResultF, any], f func(a any) any) Kind[ResultF, any] {
+	return Wrap[ResultF, any](result.Map(unwrap[result.Result[any]](fa), f))
+}
+
+func (_ resultMonad) PureAny(a any) Kind[ResultF, any] {
+	return Wrap[ResultF, any](result.Ok(a))
+}
+
+func (m resultMonad) Map2Any(fa Kind[ResultF, any], fb Kind[ResultF, any], f func(a any, b any) any) Kind[ResultF, any] {
+	return m.FlatMapAny(fa, func(a any) Kind[ResultF, any] {
+		return m.MapAny(fb, func(b any) any {
+			return f(a, b)
+		})
+	})
+}
+
+func (_ resultMonad) FlatMapAny(fa Kind[ResultF, any], f func(a any) Kind[ResultF, any]) Kind[ResultF, any] {
+	return Wrap[ResultF, any](result.FlatMap(unwrap[result.Result[any]](fa), func(a any) result.Result[any] {
+		return unwrap[result.Result[any]](f(a))
+	}))
+}
+
+// ============
+// :: Either ::
+// ============
+
+// EitherF is the brand for `either.Either` with the left type L.
+type EitherF[L any] struct{}
+
+// FromEither encodes `e` as a Kind.
+func FromEither[L any, R any](e either.Either[L, R]) Kind[EitherF[L], R] {
+	return Wrap[EitherF[L], R](either.Map(e, toAny[R]))
+}
+
+// ToEither decodes `k` into an `either.Either`.
+func ToEither[L any, R any](k Kind[EitherF[L], R]) either.Either[L, R] {
+	return either.Map(unwrap[either.Either[L, any]](Erase(k)), Cast[R])
+}
+
+// EitherMonad returns the Monad instance for `either.Either` with the left type L.
+//
+// Like `either.FlatMap`, it stops at the first `Left`.
+func EitherMonad[L any]() Monad[EitherF[L]] {
+	return eitherMonad[L]{}
+}
+
+type eitherMonad[L any] struct{}
+
+func (_ eitherMonad[L]) MapAny(fa Kind[EitherF[L], any], f func(a any) any) Kind[EitherF[L], any] {
+	return Wrap[EitherF[L], any](either.Map(unwrap[either.Either[L, any]](fa), f))
+}
+
+func (_ eitherMonad[L]) PureAny(a any) Kind[EitherF[L], any] {
+	return Wrap[EitherF[L], any](either.Right[L](a))
+}
+
+func (m eitherMonad[L]) Map2Any(fa Kind[EitherF[L], any], fb Kind[EitherF[L], any], f func(a any, b any) any) Kind[EitherF[L], any] {
+	return m.FlatMapAny(fa, func(a any) Kind[EitherF[L], any] {
+		return m.MapAny(fb, func(b any) any {
+			return f(a, b)
+		})
+	})
+}
+
+func (_ eitherMonad[L]) FlatMapAny(fa Kind[EitherF[L], any], f func(a any) Kind[EitherF[L], any]) Kind[EitherF[L], any] {
+	return Wrap[EitherF[L], any](either.FlatMap(unwrap[either.Either[L, any]](fa), func(a any) either.Either[L, any] {
+		return unwrap[either.Either[L, any]](f(a))
+	}))
+}
+
+// ==============
+// :: Identity ::
+// ==============
+
+// IdentityF is the brand for `identity.Identity`.
+type IdentityF struct{}
+
+// FromIdentity encodes `i` as a Kind.
+func FromIdentity[A any](i identity.Identity[A]) Kind[IdentityF, A] {
+	return Wrap[IdentityF, A](identity.Map(i, toAny[A]))
+}
+
+// ToIdentity decodes `k` into an `identity.Identity`.
+func ToIdentity[A any](k Kind[IdentityF, A]) identity.Identity[A] {
+	return identity.Map(unwrap[identity.Identity[any]](Erase(k)), Cast[A])
+}
+
+// IdentityMonad returns the Monad instance for `identity.Identity`.
+func IdentityMonad() Monad[IdentityF] {
+	return identityMonad{}
+}
+
+type identityMonad struct{}
+
+func (_ identityMonad) MapAny(fa Kind[IdentityF, any], f func(a any) any) Kind[IdentityF, any] {
+	return Wrap[IdentityF, any](identity.Map(unwrap[identity.Identity[any]](fa), f))
+}
+
+func (_ identityMonad) PureAny(a any) Kind[IdentityF, any] {
+	return Wrap[IdentityF, any](identity.Return(a))
+}
+
+func (_ identityMonad) Map2Any(fa Kind[IdentityF, any], fb Kind[IdentityF, any], f func(a any, b any) any) Kind[IdentityF, any] {
+	a := unwrap[identity.Identity[any]](fa).Value
+	b := unwrap[identity.Identity[any]](fb).Value
+	return Wrap[IdentityF, any](identity.Return(f(a, b)))
+}
+
+func (_ identityMonad) FlatMapAny(fa Kind[IdentityF, any], f func(a any) Kind[IdentityF, any]) Kind[IdentityF, any] {
+	return f(unwrap[identity.Identity[any]](fa).Value)
+}
+
+// ==========
+// :: Eval ::
+// ==========
+
+// EvalF is the brand for `eval.Eval`.
+type EvalF struct{}
+
+// FromEval encodes `e` as a Kind.
+func FromEval[A any](e eval.Eval[A]) Kind[EvalF, A] {
+	return Wrap[EvalF, A](eval.Map(e, toAny[A]))
+}
+
+// ToEval decodes `k` into an `eval.Eval`.
+func ToEval[A any](k Kind[EvalF, A]) eval.Eval[A] {
+	return eval.Map(unwrap[eval.Eval[any]](Erase(k)), Cast[A])
+}
+
+// EvalMonad returns the Monad instance for `eval.Eval`.
+func EvalMonad() Monad[EvalF] {
+	return evalMonad{}
+}
+
+type evalMonad struct{}
+
+func (_ evalMonad) MapAny(fa Kind[EvalF, any], f func(a any) any) Kind[EvalF, any] {
+	return Wrap[EvalF, any](eval.Map(unwrap[eval.Eval[any]](fa), f))
+}
+
+func (_ evalMonad) PureAny(a any) Kind[EvalF, any] {
+	return Wrap[EvalF, any](eval.Now(a))
+}
+
+func (m evalMonad) Map2Any(fa Kind[EvalF, any], fb Kind[EvalF, any], f func(a any, b any) any) Kind[EvalF, any] {
+	return m.FlatMapAny(fa, func(a any) Kind[EvalF, any] {
+		return m.MapAny(fb, func(b any) any {
+			return f(a, b)
+		})
+	})
+}
+
+func (_ evalMonad) FlatMapAny(fa Kind[EvalF, any], f func(a any) Kind[EvalF, any]) Kind[EvalF, any] {
+	return Wrap[EvalF, any](eval.FlatMap(unwrap[eval.Eval[any]](fa), func(a any) eval.Eval[any] {
+		return unwrap[eval.Eval[any]](f(a))
+	}))
+}
+
+// =============
+// :: Effects ::
+// =============
+
+// EffF is the brand for `effects.Eff` with the effect set E.
+type EffF[E any] struct{}
+
+// FromEff encodes `e` as a Kind.
+func FromEff[E any, A any](e effects.Eff[E, A]) Kind[EffF[E], A] {
+	return Wrap[EffF[E], A](effects.Map(e, toAny[A]))
+}
+
+// ToEff decodes `k` into an `effects.Eff`.
+func ToEff[E any, A any](k Kind[EffF[E], A]) effects.Eff[E, A] {
+	return effects.Map(unwrap[effects.Eff[E, any]](Erase(k)), Cast[A])
+}
+
+// EffMonad returns the Monad instance for `effects.Eff` with the effect set E.
+func EffMonad[E any]() Monad[EffF[E]] {
+	return effMonad[E]{}
+}
+
+type effMonad[E any] struct{}
+
+func (_ effMonad[E]) MapAny(fa Kind[EffF[E], any], f func(a any) any) Kind[EffF[E], any] {
+	return Wrap[EffF[E], any](effects.Map(unwrap[effects.Eff[E, any]](fa), f))
+}
+
+func (_ effMonad[E]) PureAny(a any) Kind[EffF[E], any] {
+	return Wrap[EffF[E], any](effects.Return[E](a))
+}
+
+func (m effMonad[E]) Map2Any(fa Kind[EffF[E], any], fb Kind[EffF[E], any], f func(a any, b any) any) Kind[EffF[E], any] {
+	return m.FlatMapAny(fa, func(a any) Kind[EffF[E], any] {
+		return m.MapAny(fb, func(b any) any {
+			return f(a, b)
+		})
+	})
+}
+
+func (_ effMonad[E]) FlatMapAny(fa Kind[EffF[E], any], f func(a any) Kind[EffF[E], any]) Kind[EffF[E], any] {
+	return Wrap[EffF[E], any](effects.FlatMap(unwrap[effects.Eff[E, any]](fa), func(a any) effects.Eff[E, any] {
+		return unwrap[effects.Eff[E, any]](f(a))
+	}))
+}
@@ -0,0 +1,82 @@
+// Package hkt provides a defunctionalized encoding of higher-kinded types,
+// which allows writing generic code that works with any Functor or Monad.
+//
+// Go cannot abstract over type constructors like `option.Optional`, so each
+// type constructor is represented by a "brand" type F, and `Kind[F, A]`
+// stands in for the type F[A]. Conversion functions like `FromOptional` and
+// `ToOptional` translate between the concrete and the encoded representation.
+//
+// Type class dictionaries (`Functor`, `Applicative` and `Monad`) operate on the
+// type-erased representation `Kind[F, any]`; the generic functions `Map`,
+// `FlatMap` etc. provide the type-safe interface on top of them.
+package hkt
+
+import "github.com/cr7pt0gr4ph7/functional-go/monads/effects"
+
+// Kind represents the type F[A] for a type constructor brand F.
+//
+// The wrapped value is the type-erased representation chosen by
+// the dictionaries for F, e.g. `option.Optional[any]` for `OptionF`.
+type Kind[F any, A any] struct {
+	value any
+}
+
+// Wrap encodes the type-erased representation `value` of a F[A] as a Kind.
+//
+// This is intended for implementing custom instances; `value` must have the
+// representation expected by the dictionaries for F.
+func Wrap[F any, A any](value any) Kind[F, A] {
+	return Kind[F, A]{value: value}
+}
+
+// Unwrap returns the type-erased representation of `k`.
+//
+// This is intended for implementing custom instances.
+func (k Kind[F, A]) Unwrap() any {
+	return k.value
+}
+
+// Erase returns the type-erased version of `k`.
+func Erase[F any, A any](k Kind[F, A]) Kind[F, any] {
+	return Kind[F, any]{value: k.value}
+}
+
+// Unerase restores the element type of `k`.
+//
+// The caller must ensure that `k` actually represents a F[A].
+func Unerase[F any, A any](k Kind[F, any]) Kind[F, A] {
+	return Kind[F, A]{value: k.value}
+}
+
+// Cast converts a type-erased value back to A.
+//
+// Unlike a plain type assertion, it also handles nil values
+// for interface types A.
+func Cast[A any](a any) A {
+	if a == nil {
+		var zero A
+		return zero
+	}
+	return a.(A)
+}
+
+func toAny[A any](a A) any {
+	return a
+}
+
+// unwrap returns the type-erased representation of `k`,
+// or the zero value of T if `k` is the zero Kind.
+// Panics if `k` wraps a value of another type, i.e. if it has the wrong brand.
+func unwrap[T any, F any](k Kind[F, any]) T {
+	if k.value == nil {
+		var zero T
+		return zero
+	}
+	return k.value.(T)
+}
+
+// Unit is the type of values without information.
+//
+// It is the same type as `effects.Unit`, so that the results
+// of `When` and `Void` can be used with the effects package.
+type Unit = effects.Unit
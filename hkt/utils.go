@@ -0,0 +1,76 @@
+package hkt
+
+// snoc is a persistent list that is built by appending to its end.
+// `Traverse` and `ForM` accumulate their results in a snoc list instead
+// of a slice, since a partial result may be continued more than once
+// (e.g. when an Eval that is not memoized is evaluated again).
+type snoc[A any] struct {
+	init *snoc[A]
+	last A
+	len  int
+}
+
+func (s *snoc[A]) append(a A) *snoc[A] {
+	n := 1
+	if s != nil {
+		n += s.len
+	}
+	return &snoc[A]{s, a, n}
+}
+
+// toSlice returns the elements of `s` in a new slice.
+func (s *snoc[A]) toSlice() []A {
+	if s == nil {
+		return []A{}
+	}
+	r := make([]A, s.len)
+	for ; s != nil; s = s.init {
+		r[s.len-1] = s.last
+	}
+	return r
+}
+
+// Traverse applies `f` to each element of `as` and combines the results.
+func Traverse[F any, A any, B any](m Applicative[F], as []A, f func(a A) Kind[F, B]) Kind[F, []B] {
+	acc := Pure(m, (*snoc[B])(nil))
+	for _, a := range as {
+		acc = Map2(m, acc, f(a), (*snoc[B]).append)
+	}
+	return Map(m, acc, (*snoc[B]).toSlice)
+}
+
+// Sequence combines a slice of computations into a computation of a slice.
+func Sequence[F any, A any](m Applicative[F], fas []Kind[F, A]) Kind[F, []A] {
+	return Traverse(m, fas, func(fa Kind[F, A]) Kind[F, A] {
+		return fa
+	})
+}
+
+// ForM applies `f` to each element of `as` in order, where each call
+// only happens after the computation returned by the previous call has run.
+// Unlike `Traverse`, it allows short-circuiting monads to skip the remaining calls.
+func ForM[F any, A any, B any](m Monad[F], as []A, f func(a A) Kind[F, B]) Kind[F, []B] {
+	var loop func(i int, acc *snoc[B]) Kind[F, []B]
+	loop = func(i int, acc *snoc[B]) Kind[F, []B] {
+		if i == len(as) {
+			return Pure(m, acc.toSlice())
+		}
+		return FlatMap(m, f(as[i]), func(b B) Kind[F, []B] {
+			return loop(i+1, acc.append(b))
+		})
+	}
+	return loop(0, nil)
+}
+
+// When returns `action` if `condition` holds, and a no-op computation otherwise.
+func When[F any](m Applicative[F], condition bool, action Kind[F, Unit]) Kind[F, Unit] {
+	if condition {
+		return action
+	}
+	return Pure(m, Unit{})
+}
+
+// Unless returns `action` if `condition` does not hold, and a no-op computation otherwise.
+func Unless[F any](m Applicative[F], condition bool, action Kind[F, Unit]) Kind[F, Unit] {
+	return When(m, !condition, action)
+}
@@ -0,0 +1,73 @@
+package laws
+
+import (
+	functional "github.com/cr7pt0gr4ph7/functional-go"
+	"github.com/cr7pt0gr4ph7/functional-go/quickcheck"
+)
+
+// SemigroupLaws returns the laws for a Semigroup:
+//
+//   - Associativity: (x <> y) <> z == x <> (y <> z)
+func SemigroupLaws[A any](s functional.Semigroup[A], gen quickcheck.Gen[A], eq func(x A, y A) bool) []Law {
+	return []Law{
+		forAll3("Semigroup associativity", gen, gen, gen, func(x A, y A, z A) bool {
+			return eq(s.Combine(s.Combine(x, y), z), s.Combine(x, s.Combine(y, z)))
+		}),
+	}
+}
+
+// MonoidLaws returns the laws for a Monoid:
+//
+//   - The Semigroup laws
+//   - Left identity: Empty() <> x == x
+//   - Right identity: x <> Empty() == x
+func MonoidLaws[A any](m functional.Monoid[A], gen quickcheck.Gen[A], eq func(x A, y A) bool) []Law {
+	return append(SemigroupLaws[A](m, gen, eq),
+		ForAll("Monoid left identity", gen, func(x A) bool {
+			return eq(m.Combine(m.Empty(), x), x)
+		}),
+		ForAll("Monoid right identity", gen, func(x A) bool {
+			return eq(m.Combine(x, m.Empty()), x)
+		}),
+	)
+}
+
+// FunctorLaws returns the laws for the functor `FA` with the mapping function `fmap`:
+//
+//   - Identity: fmap(fa, id) == fa
+//   - Composition: fmap(fmap(fa, f), g) == fmap(fa, g . f)
+//
+// The functions `f` and `g` are picked from `fns`.
+func FunctorLaws[FA any, A any](fmap func(fa FA, f func(a A) A) FA, gen quickcheck.Gen[FA], fns []func(a A) A, eq func(x FA, y FA) bool) []Law {
+	genF := choices(fns)
+	return []Law{
+		ForAll("Functor identity", gen, func(fa FA) bool {
+			return eq(fmap(fa, func(a A) A { return a }), fa)
+		}),
+		forAll3[FA, choice[func(a A) A], choice[func(a A) A]]("Functor composition", gen, genF, genF, func(fa FA, f choice[func(a A) A], g choice[func(a A) A]) bool {
+			return eq(fmap(fmap(fa, f.value), g.value), fmap(fa, func(a A) A { return g.value(f.value(a)) }))
+		}),
+	}
+}
+
+// MonadLaws returns the laws for the monad `FA` with the functions `pure` and `flatMap`:
+//
+//   - Left identity: flatMap(pure(a), f) == f(a)
+//   - Right identity: flatMap(fa, pure) == fa
+//   - Associativity: flatMap(flatMap(fa, f), g) == flatMap(fa, a => flatMap(f(a), g))
+//
+// The functions `f` and `g` are picked from `fns`.
+func MonadLaws[FA any, A any](pure func(a A) FA, flatMap func(fa FA, f func(a A) FA) FA, genA quickcheck.Gen[A], genFA quickcheck.Gen[FA], fns []func(a A) FA, eq func(x FA, y FA) bool) []Law {
+	genF := choices(fns)
+	return []Law{
+		forAll2[A, choice[func(a A) FA]]("Monad left identity", genA, genF, func(a A, f choice[func(a A) FA]) bool {
+			return eq(flatMap(pure(a), f.value), f.value(a))
+		}),
+		ForAll("Monad right identity", genFA, func(fa FA) bool {
+			return eq(flatMap(fa, pure), fa)
+		}),
+		forAll3[FA, choice[func(a A) FA], choice[func(a A) FA]]("Monad associativity", genFA, genF, genF, func(fa FA, f choice[func(a A) FA], g choice[func(a A) FA]) bool {
+			return eq(flatMap(flatMap(fa, f.value), g.value), flatMap(fa, func(a A) FA { return flatMap(f.value(a), g.value) }))
+		}),
+	}
+}
@@ -0,0 +1,67 @@
+package laws
+
+import (
+	"github.com/cr7pt0gr4ph7/functional-go/eq"
+	"github.com/cr7pt0gr4ph7/functional-go/quickcheck"
+)
+
+// EqLaws returns the laws for an Eq:
+//
+//   - Reflexivity: x == x
+//   - Symmetry: x == y implies y == x
+//   - Transitivity: x == y and y == z implies x == z
+func EqLaws[A any](e eq.Eq[A], gen quickcheck.Gen[A]) []Law {
+	return []Law{
+		ForAll("Eq reflexivity", gen, func(x A) bool {
+			return e.Equal(x, x)
+		}),
+		forAll2("Eq symmetry", gen, gen, func(x A, y A) bool {
+			return e.Equal(x, y) == e.Equal(y, x)
+		}),
+		forAll3("Eq transitivity", gen, gen, gen, func(x A, y A, z A) bool {
+			return !e.Equal(x, y) || !e.Equal(y, z) || e.Equal(x, z)
+		}),
+	}
+}
+
+// OrdLaws returns the laws for an Ord:
+//
+//   - The Eq laws
+//   - Consistency: x == y if and only if Compare(x, y) == 0
+//   - Antisymmetry: Compare(x, y) and Compare(y, x) have opposite signs
+//   - Transitivity: x <= y and y <= z implies x <= z
+func OrdLaws[A any](o eq.Ord[A], gen quickcheck.Gen[A]) []Law {
+	sign := func(c int) int {
+		switch {
+		case c < 0:
+			return -1
+		case c > 0:
+			return 1
+		default:
+			return 0
+		}
+	}
+	return append(EqLaws[A](o, gen),
+		forAll2("Ord consistency", gen, gen, func(x A, y A) bool {
+			return o.Equal(x, y) == (o.Compare(x, y) == 0)
+		}),
+		forAll2("Ord antisymmetry", gen, gen, func(x A, y A) bool {
+			return sign(o.Compare(x, y)) == -sign(o.Compare(y, x))
+		}),
+		forAll3("Ord transitivity", gen, gen, gen, func(x A, y A, z A) bool {
+			return o.Compare(x, y) > 0 || o.Compare(y, z) > 0 || o.Compare(x, z) <= 0
+		}),
+	)
+}
+
+// HashLaws returns the laws for a Hash:
+//
+//   - The Eq laws
+//   - Consistency: x == y implies Hash(x) == Hash(y)
+func HashLaws[A any](h eq.Hash[A], gen quickcheck.Gen[A]) []Law {
+	return append(EqLaws[A](h, gen),
+		forAll2("Hash consistency", gen, gen, func(x A, y A) bool {
+			return !h.Equal(x, y) || h.Hash(x) == h.Hash(y)
+		}),
+	)
+}
@@ -0,0 +1,59 @@
+package laws
+
+import (
+	"fmt"
+
+	"github.com/cr7pt0gr4ph7/functional-go/quickcheck"
+)
+
+// choice is a value picked from a list of functions.
+//
+// Functions cannot be printed in a meaningful way, so counterexamples
+// refer to them by their position in the list instead.
+type choice[T any] struct {
+	index int
+	value T
+}
+
+func (c choice[T]) String() string {
+	return fmt.Sprintf("fns[%d]", c.index)
+}
+
+// choices generates values from a list, shrinking towards the start of the list.
+func choices[T any](values []T) quickcheck.Gen[choice[T]] {
+	if len(values) == 0 {
+		panic("laws: no functions given")
+	}
+	return quickcheck.Map(quickcheck.IntRange(0, len(values)-1), func(i int) choice[T] {
+		return choice[T]{i, values[i]}
+	})
+}
+
+type tuple2[A any, B any] struct {
+	a A
+	b B
+}
+
+type tuple3[A any, B any, C any] struct {
+	a A
+	b B
+	c C
+}
+
+func forAll2[A any, B any](name string, ga quickcheck.Gen[A], gb quickcheck.Gen[B], property func(a A, b B) bool) Law {
+	gen := quickcheck.Map2(ga, gb, func(a A, b B) tuple2[A, B] {
+		return tuple2[A, B]{a, b}
+	})
+	return forAll(name, gen,
+		func(t tuple2[A, B]) bool { return property(t.a, t.b) },
+		func(t tuple2[A, B]) []any { return []any{t.a, t.b} })
+}
+
+func forAll3[A any, B any, C any](name string, ga quickcheck.Gen[A], gb quickcheck.Gen[B], gc quickcheck.Gen[C], property func(a A, b B, c C) bool) Law {
+	gen := quickcheck.Map3(ga, gb, gc, func(a A, b B, c C) tuple3[A, B, C] {
+		return tuple3[A, B, C]{a, b, c}
+	})
+	return forAll(name, gen,
+		func(t tuple3[A, B, C]) bool { return property(t.a, t.b, t.c) },
+		func(t tuple3[A, B, C]) []any { return []any{t.a, t.b, t.c} })
+}
@@ -0,0 +1,85 @@
+// Package laws checks that instances of algebraic structures obey their laws.
+//
+// Laws are checked by evaluating them for randomly generated inputs.
+// When a law does not hold, the failing inputs are shrunk to a minimal
+// counterexample before being reported.
+package laws
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/cr7pt0gr4ph7/functional-go/quickcheck"
+)
+
+// Law represents a single property that an instance must satisfy.
+type Law struct {
+	Name  string
+	check func(config Config) *Violation
+}
+
+// Config controls how laws are checked.
+type Config = quickcheck.Config
+
+// Violation describes a law that does not hold.
+type Violation struct {
+	Law string
+	// Minimal counterexample found.
+	Args []any
+	// Value of the recovered panic, if the law panicked.
+	Panic any
+}
+
+func (v *Violation) Error() string {
+	args := make([]string, len(v.Args))
+	for i, arg := range v.Args {
+		args[i] = fmt.Sprintf("%v", arg)
+	}
+	msg := fmt.Sprintf("law %q violated for (%s)", v.Law, strings.Join(args, ", "))
+	if v.Panic != nil {
+		msg += fmt.Sprintf(": panic: %v", v.Panic)
+	}
+	return msg
+}
+
+// Verify checks `laws` and returns the first violation found, or nil.
+func Verify(config Config, laws ...Law) error {
+	for _, law := range laws {
+		if v := law.check(config); v != nil {
+			return v
+		}
+	}
+	return nil
+}
+
+// Check checks `laws` and reports every violation as an error on `t`.
+func Check(t testing.TB, config Config, laws ...Law) {
+	t.Helper()
+	for _, law := range laws {
+		if v := law.check(config); v != nil {
+			t.Error(v)
+		}
+	}
+}
+
+// ForAll returns a law that holds if `property` returns true for all values of `gen`.
+//
+// This can be used to define laws for custom structures.
+func ForAll[T any](name string, gen quickcheck.Gen[T], property func(value T) bool) Law {
+	return forAll(name, gen, property, func(value T) []any {
+		return []any{value}
+	})
+}
+
+func forAll[T any](name string, gen quickcheck.Gen[T], property func(value T) bool, args func(value T) []any) Law {
+	return Law{
+		Name: name,
+		check: func(config Config) *Violation {
+			if f := quickcheck.Run(config, gen, property); f != nil {
+				return &Violation{Law: name, Args: args(f.Value), Panic: f.Panic}
+			}
+			return nil
+		},
+	}
+}
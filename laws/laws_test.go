@@ -0,0 +1,137 @@
+package laws
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	functional "github.com/cr7pt0gr4ph7/functional-go"
+	"github.com/cr7pt0gr4ph7/functional-go/eval"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/effects"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/either"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/identity"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+	"github.com/cr7pt0gr4ph7/functional-go/quickcheck"
+)
+
+func equal[A comparable](x A, y A) bool {
+	return x == y
+}
+
+var ints = quickcheck.IntRange(-1000, 1000)
+
+var optionalInts = quickcheck.OptionalOf(ints)
+
+var endos = []func(a int) int{
+	func(a int) int { return a + 1 },
+	func(a int) int { return a * 2 },
+	func(a int) int { return -a },
+}
+
+func TestMonoidInstances(t *testing.T) {
+	Check(t, Config{}, MonoidLaws(functional.SumMonoid[int](), ints, equal[int])...)
+	Check(t, Config{}, MonoidLaws(functional.ProductMonoid[int](), ints, equal[int])...)
+	Check(t, Config{}, MonoidLaws(functional.OptionMonoid(functional.MaxSemigroup[int]()), optionalInts, equal[option.Optional[int]])...)
+	Check(t, Config{}, MonoidLaws(functional.FirstMonoid[int](), optionalInts, equal[option.Optional[int]])...)
+	Check(t, Config{}, MonoidLaws(functional.StringMonoid(), quickcheck.Elements("", "a", "bc", "def"), equal[string])...)
+	Check(t, Config{}, MonoidLaws(functional.AllMonoid(), quickcheck.Elements(false, true), equal[bool])...)
+}
+
+func TestOptionalMonad(t *testing.T) {
+	fns := []func(a int) option.Optional[int]{
+		func(a int) option.Optional[int] { return option.Some(a + 1) },
+		func(a int) option.Optional[int] {
+			return option.Filter(option.Some(a), func(a int) bool { return a%2 == 0 })
+		},
+		func(a int) option.Optional[int] { return option.None[int]() },
+	}
+	Check(t, Config{}, FunctorLaws(option.Map[int, int], optionalInts, endos, equal[option.Optional[int]])...)
+	Check(t, Config{}, MonadLaws(option.Some[int], option.FlatMap[int, int], ints, optionalInts, fns, equal[option.Optional[int]])...)
+}
+
+func TestResultMonad(t *testing.T) {
+	errOdd := errors.New("odd")
+	results := quickcheck.ResultOf(ints, quickcheck.Const(errOdd))
+	fns := []func(a int) result.Result[int]{
+		func(a int) result.Result[int] { return result.Ok(a * 3) },
+		func(a int) result.Result[int] {
+			if a%2 != 0 {
+				return result.Error[int](errOdd)
+			}
+			return result.Ok(a / 2)
+		},
+	}
+	Check(t, Config{}, FunctorLaws(result.Map[int, int], results, endos, equal[result.Result[int]])...)
+	Check(t, Config{}, MonadLaws(result.Ok[int], result.FlatMap[int, int], ints, results, fns, equal[result.Result[int]])...)
+}
+
+func TestEitherMonad(t *testing.T) {
+	eithers := quickcheck.OneOf(
+		quickcheck.Map(ints, either.Right[string, int]),
+		quickcheck.Map(quickcheck.Elements("a", "b"), either.Left[string, int]),
+	)
+	fns := []func(a int) either.Either[string, int]{
+		func(a int) either.Either[string, int] { return either.Right[string](a - 1) },
+		func(a int) either.Either[string, int] {
+			if a < 0 {
+				return either.Left[string, int]("negative")
+			}
+			return either.Right[string](a)
+		},
+	}
+	Check(t, Config{}, FunctorLaws(either.Map[string, int, int], eithers, endos, equal[either.Either[string, int]])...)
+	Check(t, Config{}, MonadLaws(either.Right[string, int], either.FlatMap[string, int, int], ints, eithers, fns, equal[either.Either[string, int]])...)
+}
+
+func TestIdentityMonad(t *testing.T) {
+	identities := quickcheck.Map(ints, identity.Return[int])
+	fns := []func(a int) identity.Identity[int]{
+		func(a int) identity.Identity[int] { return identity.Return(a - 7) },
+	}
+	Check(t, Config{}, FunctorLaws(identity.Map[int, int], identities, endos, equal[identity.Identity[int]])...)
+	Check(t, Config{}, MonadLaws(identity.Return[int], identity.FlatMap[int, int], ints, identities, fns, equal[identity.Identity[int]])...)
+}
+
+func TestEvalMonad(t *testing.T) {
+	evalEq := func(x eval.Eval[int], y eval.Eval[int]) bool {
+		return x.Value() == y.Value()
+	}
+	evals := quickcheck.OneOf(
+		quickcheck.Map(ints, eval.Now[int]),
+		quickcheck.Map(ints, func(a int) eval.Eval[int] {
+			return eval.Later(func() int { return a })
+		}),
+		quickcheck.Map(ints, func(a int) eval.Eval[int] {
+			return eval.Always(func() int { return a })
+		}),
+	)
+	fns := []func(a int) eval.Eval[int]{
+		func(a int) eval.Eval[int] { return eval.Now(a + 2) },
+		func(a int) eval.Eval[int] { return eval.Later(func() int { return a * a }) },
+	}
+	Check(t, Config{}, FunctorLaws(eval.Map[int, int], evals, endos, evalEq)...)
+	Check(t, Config{}, MonadLaws(eval.Now[int], eval.FlatMap[int, int], ints, evals, fns, evalEq)...)
+}
+
+type noEffects interface{}
+
+func TestEffMonad(t *testing.T) {
+	effEq := func(x effects.Eff[noEffects, int], y effects.Eff[noEffects, int]) bool {
+		return effects.RunPureOrFail(x) == effects.RunPureOrFail(y)
+	}
+	effs := quickcheck.Map(ints, effects.Return[noEffects, int])
+	fns := []func(a int) effects.Eff[noEffects, int]{
+		func(a int) effects.Eff[noEffects, int] { return effects.Return[noEffects](a + 5) },
+		func(a int) effects.Eff[noEffects, int] { return effects.Map(effects.Return[noEffects](a), endos[1]) },
+	}
+	Check(t, Config{}, FunctorLaws(effects.Map[noEffects, int, int], effs, endos, effEq)...)
+	Check(t, Config{}, MonadLaws(effects.Return[noEffects, int], effects.FlatMap[noEffects, int, int], ints, effs, fns, effEq)...)
+}
+
+func ExampleVerify() {
+	subtraction := functional.NewSemigroup(func(x int, y int) int { return x - y })
+	err := Verify(Config{Seed: 42}, SemigroupLaws(subtraction, quickcheck.IntRange(-100, 100), equal[int])...)
+	fmt.Println(err)
+	// Output: law "Semigroup associativity" violated for (0, 0, -1)
+}
@@ -0,0 +1,67 @@
+package laws
+
+import (
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+	"github.com/cr7pt0gr4ph7/functional-go/optics"
+	"github.com/cr7pt0gr4ph7/functional-go/quickcheck"
+)
+
+// LensLaws returns the laws for a Lens:
+//
+//   - GetSet: Set(s, Get(s)) == s
+//   - SetGet: Get(Set(s, a)) == a
+//   - SetSet: Set(Set(s, a1), a2) == Set(s, a2)
+func LensLaws[S any, A any](l optics.Lens[S, A], genS quickcheck.Gen[S], genA quickcheck.Gen[A], eqS func(x S, y S) bool, eqA func(x A, y A) bool) []Law {
+	return []Law{
+		ForAll("Lens GetSet", genS, func(s S) bool {
+			return eqS(l.Set(s, l.Get(s)), s)
+		}),
+		forAll2("Lens SetGet", genS, genA, func(s S, a A) bool {
+			return eqA(l.Get(l.Set(s, a)), a)
+		}),
+		forAll3("Lens SetSet", genS, genA, genA, func(s S, a1 A, a2 A) bool {
+			return eqS(l.Set(l.Set(s, a1), a2), l.Set(s, a2))
+		}),
+	}
+}
+
+// PrismLaws returns the laws for a Prism:
+//
+//   - ReviewPreview: Preview(Review(a)) == Some(a)
+//   - PreviewReview: Preview(s) == Some(a) implies Review(a) == s
+func PrismLaws[S any, A any](p optics.Prism[S, A], genS quickcheck.Gen[S], genA quickcheck.Gen[A], eqS func(x S, y S) bool, eqA func(x A, y A) bool) []Law {
+	return []Law{
+		ForAll("Prism ReviewPreview", genA, func(a A) bool {
+			v, ok := p.Preview(p.Review(a)).Value()
+			return ok && eqA(v, a)
+		}),
+		ForAll("Prism PreviewReview", genS, func(s S) bool {
+			a, ok := p.Preview(s).Value()
+			return !ok || eqS(p.Review(a), s)
+		}),
+	}
+}
+
+// OptionalLaws returns the laws for an Optional:
+//
+//   - GetSet: Set(s, a) == s if GetOption(s) is None or Some(a)
+//   - SetGet: GetOption(Set(s, a)) == Map(GetOption(s), _ => a)
+//   - SetSet: Set(Set(s, a1), a2) == Set(s, a2)
+func OptionalLaws[S any, A any](o optics.Optional[S, A], genS quickcheck.Gen[S], genA quickcheck.Gen[A], eqS func(x S, y S) bool, eqA func(x A, y A) bool) []Law {
+	return []Law{
+		forAll2("Optional GetSet", genS, genA, func(s S, a A) bool {
+			if current, ok := o.GetOption(s).Value(); ok {
+				return eqS(o.Set(s, current), s)
+			}
+			return eqS(o.Set(s, a), s)
+		}),
+		forAll2("Optional SetGet", genS, genA, func(s S, a A) bool {
+			got, gotOk := o.GetOption(o.Set(s, a)).Value()
+			want, wantOk := option.Map(o.GetOption(s), func(_ A) A { return a }).Value()
+			return gotOk == wantOk && (!gotOk || eqA(got, want))
+		}),
+		forAll3("Optional SetSet", genS, genA, genA, func(s S, a1 A, a2 A) bool {
+			return eqS(o.Set(o.Set(s, a1), a2), o.Set(s, a2))
+		}),
+	}
+}
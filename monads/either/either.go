@@ -0,0 +1,136 @@
+// Package either provides `Either[L, R]`, which holds either a value of type L
+// or a value of type R.
+//
+// By convention, `Right` holds the "successful" value, so `Map` and `FlatMap`
+// operate on it. Unlike `result.Result`, the left side can be any type.
+package either
+
+import (
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+)
+
+// Either holds either a left value of type L or a right value of type R.
+// The zero value is `Left` with the zero value of L.
+type Either[L any, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+func Left[L any, R any](value L) Either[L, R] {
+	return Either[L, R]{left: value}
+}
+
+func Right[L any, R any](value R) Either[L, R] {
+	return Either[L, R]{right: value, isRight: true}
+}
+
+func (e Either[_, _]) IsLeft() bool {
+	return !e.isRight
+}
+
+func (e Either[_, _]) IsRight() bool {
+	return e.isRight
+}
+
+func (e Either[L, _]) Left() (value L, ok bool) {
+	return e.left, !e.isRight
+}
+
+func (e Either[_, R]) Right() (value R, ok bool) {
+	return e.right, e.isRight
+}
+
+func (e Either[_, R]) RightOrElse(fallback R) R {
+	if e.isRight {
+		return e.right
+	}
+	return fallback
+}
+
+// Swap exchanges the left and the right side.
+func (e Either[L, R]) Swap() Either[R, L] {
+	return Either[R, L]{left: e.right, right: e.left, isRight: !e.isRight}
+}
+
+func Fold[L any, R any, T any](e Either[L, R], whenLeft func(value L) T, whenRight func(value R) T) T {
+	if e.isRight {
+		return whenRight(e.right)
+	}
+	return whenLeft(e.left)
+}
+
+func Map[L any, R any, T any](e Either[L, R], mapping func(value R) T) Either[L, T] {
+	if e.isRight {
+		return Right[L](mapping(e.right))
+	}
+	return Left[L, T](e.left)
+}
+
+func MapLeft[L any, R any, T any](e Either[L, R], mapping func(value L) T) Either[T, R] {
+	if e.isRight {
+		return Right[T](e.right)
+	}
+	return Left[T, R](mapping(e.left))
+}
+
+func Bimap[L any, R any, T any, U any](e Either[L, R], mapLeft func(value L) T, mapRight func(value R) U) Either[T, U] {
+	if e.isRight {
+		return Right[T](mapRight(e.right))
+	}
+	return Left[T, U](mapLeft(e.left))
+}
+
+func FlatMap[L any, R any, T any](e Either[L, R], mapping func(value R) Either[L, T]) Either[L, T] {
+	if e.isRight {
+		return mapping(e.right)
+	}
+	return Left[L, T](e.left)
+}
+
+func Flatten[L any, R any](e Either[L, Either[L, R]]) Either[L, R] {
+	if e.isRight {
+		return e.right
+	}
+	return Left[L, R](e.left)
+}
+
+// =================
+// :: Conversions ::
+// =================
+
+// FromResult converts an error result to `Left` and a successful result to `Right`.
+func FromResult[R any](r result.Result[R]) Either[error, R] {
+	if v, err := r.Extract(); err != nil {
+		return Left[error, R](err)
+	} else {
+		return Right[error](v)
+	}
+}
+
+// ToResult converts `Left` to an error result and `Right` to a successful result.
+func ToResult[R any](e Either[error, R]) result.Result[R] {
+	if e.isRight {
+		return result.Ok(e.right)
+	}
+	return result.Error[R](e.left)
+}
+
+// FromOptional converts a present value to `Right`, and `None` to `Left(ifNone)`.
+func FromOptional[L any, R any](o option.Optional[R], ifNone L) Either[L, R] {
+	if v, ok := o.Value(); ok {
+		return Right[L](v)
+	}
+	return Left[L, R](ifNone)
+}
+
+// ToOptional returns the right value, or `None` if `e` is `Left`.
+func ToOptional[L any, R any](e Either[L, R]) option.Optional[R] {
+	return option.FromValueOrFalse(e.right, e.isRight)
+}
+
+// LeftToOptional returns the left value, or `None` if `e` is `Right`.
+func LeftToOptional[L any, R any](e Either[L, R]) option.Optional[L] {
+	return option.FromValueOrFalse(e.left, !e.isRight)
+}
@@ -0,0 +1,47 @@
+package either
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+type cacheMiss struct{ key string }
+
+func lookup(key string) Either[cacheMiss, int] {
+	if key == "a" {
+		return Right[cacheMiss](1)
+	}
+	return Left[cacheMiss, int](cacheMiss{key})
+}
+
+func ExampleFold() {
+	for _, key := range []string{"a", "b"} {
+		fmt.Println(Fold(lookup(key),
+			func(m cacheMiss) string { return "miss: " + m.key },
+			func(v int) string { return "hit: " + strconv.Itoa(v) }))
+	}
+	// Output:
+	// hit: 1
+	// miss: b
+}
+
+func ExampleMapLeft() {
+	e := MapLeft(lookup("x"), func(m cacheMiss) error { return errors.New("no entry for " + m.key) })
+	fmt.Println(ToResult(e).Extract())
+	// Output: 0 no entry for x
+}
+
+func ExampleLift2() {
+	add := Lift2[cacheMiss](func(a int, b int) int { return a + b })
+	fmt.Println(add(lookup("a"), lookup("a")).Right())
+	fmt.Println(add(lookup("a"), lookup("c")).Left())
+	// Output:
+	// 2 true
+	// {c} true
+}
+
+func ExampleEither_Swap() {
+	fmt.Println(Right[string](1).Swap().Left())
+	// Output: 1 true
+}
@@ -0,0 +1,49 @@
+package either
+
+func Lift0[L any, A any, B any](f func() B) func(_ Either[L, A]) Either[L, B] {
+	return func(a Either[L, A]) Either[L, B] {
+		if a.isRight {
+			return Right[L](f())
+		}
+		return Left[L, B](a.left)
+	}
+}
+
+func Lift1[L any, A any, B any](f func(a A) B) func(a Either[L, A]) Either[L, B] {
+	return func(a Either[L, A]) Either[L, B] {
+		if a.isRight {
+			return Right[L](f(a.right))
+		}
+		return Left[L, B](a.left)
+	}
+}
+
+// Lift2 lifts `f` to Either values. If several arguments are `Left`,
+// the leftmost one is returned.
+func Lift2[L any, A any, B any, C any](f func(a A, b B) C) func(a Either[L, A], b Either[L, B]) Either[L, C] {
+	return func(a Either[L, A], b Either[L, B]) Either[L, C] {
+		switch {
+		case !a.isRight:
+			return Left[L, C](a.left)
+		case !b.isRight:
+			return Left[L, C](b.left)
+		}
+		return Right[L](f(a.right, b.right))
+	}
+}
+
+// Lift3 lifts `f` to Either values. If several arguments are `Left`,
+// the leftmost one is returned.
+func Lift3[L any, A any, B any, C any, D any](f func(a A, b B, c C) D) func(a Either[L, A], b Either[L, B], c Either[L, C]) Either[L, D] {
+	return func(a Either[L, A], b Either[L, B], c Either[L, C]) Either[L, D] {
+		switch {
+		case !a.isRight:
+			return Left[L, D](a.left)
+		case !b.isRight:
+			return Left[L, D](b.left)
+		case !c.isRight:
+			return Left[L, D](c.left)
+		}
+		return Right[L](f(a.right, b.right, c.right))
+	}
+}
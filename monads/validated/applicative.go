@@ -0,0 +1,145 @@
+package validated
+
+import (
+	functional "github.com/cr7pt0gr4ph7/functional-go"
+)
+
+// The combinators in this file evaluate all of their arguments
+// and report the errors of every invalid argument.
+// The variants with a `With` suffix combine errors using `policy`,
+// while all others use `ErrorSemigroup()`.
+
+// ================
+// :: Map2..Map6 ::
+// ================
+
+// Map2 combines the values of `a` and `b` using `combine`.
+func Map2[A any, B any, R any](a Validated[A], b Validated[B], combine func(a A, b B) R) Validated[R] {
+	return Map2With[A, B, R](ErrorSemigroup(), a, b, combine)
+}
+
+// Map2With is like `Map2`, but combines errors using `policy`.
+func Map2With[A any, B any, R any](policy functional.Semigroup[error], a Validated[A], b Validated[B], combine func(a A, b B) R) Validated[R] {
+	if err := combineErrors(policy, a.err, b.err); err != nil {
+		return Invalid[R](err)
+	}
+	return Valid(combine(a.value, b.value))
+}
+
+// Map3 combines the values of `a`, `b` and `c` using `combine`.
+func Map3[A any, B any, C any, R any](a Validated[A], b Validated[B], c Validated[C], combine func(a A, b B, c C) R) Validated[R] {
+	return Map3With[A, B, C, R](ErrorSemigroup(), a, b, c, combine)
+}
+
+// Map3With is like `Map3`, but combines errors using `policy`.
+func Map3With[A any, B any, C any, R any](policy functional.Semigroup[error], a Validated[A], b Validated[B], c Validated[C], combine func(a A, b B, c C) R) Validated[R] {
+	if err := combineErrors(policy, a.err, b.err, c.err); err != nil {
+		return Invalid[R](err)
+	}
+	return Valid(combine(a.value, b.value, c.value))
+}
+
+// Map4 combines the values of `a`, `b`, `c` and `d` using `combine`.
+func Map4[A any, B any, C any, D any, R any](a Validated[A], b Validated[B], c Validated[C], d Validated[D], combine func(a A, b B, c C, d D) R) Validated[R] {
+	return Map4With[A, B, C, D, R](ErrorSemigroup(), a, b, c, d, combine)
+}
+
+// Map4With is like `Map4`, but combines errors using `policy`.
+func Map4With[A any, B any, C any, D any, R any](policy functional.Semigroup[error], a Validated[A], b Validated[B], c Validated[C], d Validated[D], combine func(a A, b B, c C, d D) R) Validated[R] {
+	if err := combineErrors(policy, a.err, b.err, c.err, d.err); err != nil {
+		return Invalid[R](err)
+	}
+	return Valid(combine(a.value, b.value, c.value, d.value))
+}
+
+// Map5 combines the values of `a`, `b`, `c`, `d` and `e` using `combine`.
+func Map5[A any, B any, C any, D any, E any, R any](a Validated[A], b Validated[B], c Validated[C], d Validated[D], e Validated[E], combine func(a A, b B, c C, d D, e E) R) Validated[R] {
+	return Map5With[A, B, C, D, E, R](ErrorSemigroup(), a, b, c, d, e, combine)
+}
+
+// Map5With is like `Map5`, but combines errors using `policy`.
+func Map5With[A any, B any, C any, D any, E any, R any](policy functional.Semigroup[error], a Validated[A], b Validated[B], c Validated[C], d Validated[D], e Validated[E], combine func(a A, b B, c C, d D, e E) R) Validated[R] {
+	if err := combineErrors(policy, a.err, b.err, c.err, d.err, e.err); err != nil {
+		return Invalid[R](err)
+	}
+	return Valid(combine(a.value, b.value, c.value, d.value, e.value))
+}
+
+// Map6 combines the values of `a`, `b`, `c`, `d`, `e` and `f` using `combine`.
+func Map6[A any, B any, C any, D any, E any, F any, R any](a Validated[A], b Validated[B], c Validated[C], d Validated[D], e Validated[E], f Validated[F], combine func(a A, b B, c C, d D, e E, f F) R) Validated[R] {
+	return Map6With[A, B, C, D, E, F, R](ErrorSemigroup(), a, b, c, d, e, f, combine)
+}
+
+// Map6With is like `Map6`, but combines errors using `policy`.
+func Map6With[A any, B any, C any, D any, E any, F any, R any](policy functional.Semigroup[error], a Validated[A], b Validated[B], c Validated[C], d Validated[D], e Validated[E], f Validated[F], combine func(a A, b B, c C, d D, e E, f F) R) Validated[R] {
+	if err := combineErrors(policy, a.err, b.err, c.err, d.err, e.err, f.err); err != nil {
+		return Invalid[R](err)
+	}
+	return Valid(combine(a.value, b.value, c.value, d.value, e.value, f.value))
+}
+
+// ===========================
+// :: Traverse and Sequence ::
+// ===========================
+
+// Traverse applies `f` to each element of `as` and collects the results.
+func Traverse[A any, B any](as []A, f func(elem A) Validated[B]) Validated[[]B] {
+	return TraverseWith(ErrorSemigroup(), as, f)
+}
+
+// TraverseWith is like `Traverse`, but combines errors using `policy`.
+func TraverseWith[A any, B any](policy functional.Semigroup[error], as []A, f func(elem A) Validated[B]) Validated[[]B] {
+	r := make([]B, 0, len(as))
+	var errs []error
+	for _, a := range as {
+		v := f(a)
+		if v.err != nil {
+			errs = append(errs, v.err)
+		} else if errs == nil {
+			r = append(r, v.value)
+		}
+	}
+	if err := combineErrors(policy, errs...); err != nil {
+		return Invalid[[]B](err)
+	}
+	return Valid(r)
+}
+
+// Sequence turns a slice of Validated values into a Validated slice.
+func Sequence[A any](vs []Validated[A]) Validated[[]A] {
+	return SequenceWith(ErrorSemigroup(), vs)
+}
+
+// SequenceWith is like `Sequence`, but combines errors using `policy`.
+func SequenceWith[A any](policy functional.Semigroup[error], vs []Validated[A]) Validated[[]A] {
+	return TraverseWith(policy, vs, func(v Validated[A]) Validated[A] {
+		return v
+	})
+}
+
+// TraverseMap applies `f` to each entry of `m` and collects the results
+// into a map with the same keys.
+//
+// The order in which `f` is called, and therefore the order of the
+// combined errors, is unspecified.
+func TraverseMap[K comparable, A any, B any](m map[K]A, f func(key K, value A) Validated[B]) Validated[map[K]B] {
+	return TraverseMapWith(ErrorSemigroup(), m, f)
+}
+
+// TraverseMapWith is like `TraverseMap`, but combines errors using `policy`.
+func TraverseMapWith[K comparable, A any, B any](policy functional.Semigroup[error], m map[K]A, f func(key K, value A) Validated[B]) Validated[map[K]B] {
+	r := make(map[K]B, len(m))
+	var errs []error
+	for k, a := range m {
+		v := f(k, a)
+		if v.err != nil {
+			errs = append(errs, v.err)
+		} else if errs == nil {
+			r[k] = v.value
+		}
+	}
+	if err := combineErrors(policy, errs...); err != nil {
+		return Invalid[map[K]B](err)
+	}
+	return Valid(r)
+}
@@ -0,0 +1,138 @@
+// Package validated provides `Validated[T]`, a result type whose applicative
+// combinators accumulate all errors instead of stopping at the first one.
+//
+// This is useful e.g. for form validation, where all problems should be
+// reported at once. Use `result.Result` if later steps depend on earlier ones.
+package validated
+
+import (
+	"errors"
+
+	functional "github.com/cr7pt0gr4ph7/functional-go"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+)
+
+// errNoError replaces a nil error passed to `Invalid`.
+// It is the same sentinel error that `result.Error` uses.
+var errNoError = result.Error[struct{}](nil).Error()
+
+// Validated holds either a valid value or the errors that prevented its computation.
+type Validated[T any] struct {
+	value T
+	err   error
+}
+
+func Valid[T any](value T) Validated[T] {
+	return Validated[T]{value: value}
+}
+
+func Invalid[T any](err error) Validated[T] {
+	if err == nil {
+		err = errNoError
+	}
+	return Validated[T]{err: err}
+}
+
+// From is the counterpart of `result.From`.
+func From[T any](value T, err error) Validated[T] {
+	return Validated[T]{value: value, err: err}
+}
+
+// FromResult converts `r` to a Validated, retaining both its value and its error.
+func FromResult[T any](r result.Result[T]) Validated[T] {
+	return From(r.Extract())
+}
+
+// ToResult converts `v` to a `result.Result`, retaining both its value and its error.
+func ToResult[T any](v Validated[T]) result.Result[T] {
+	return result.From(v.Extract())
+}
+
+func (v Validated[_]) IsValid() bool {
+	return v.err == nil
+}
+
+func (v Validated[_]) IsInvalid() bool {
+	return v.err != nil
+}
+
+func (v Validated[T]) Extract() (T, error) {
+	return v.value, v.err
+}
+
+func (v Validated[_]) Error() error {
+	return v.err
+}
+
+func Map[A any, B any](v Validated[A], mapValue func(value A) B) Validated[B] {
+	if v.err != nil {
+		return Invalid[B](v.err)
+	}
+	return Valid(mapValue(v.value))
+}
+
+func MapError[A any](v Validated[A], mapError func(err error) error) Validated[A] {
+	if v.err != nil {
+		return Invalid[A](mapError(v.err))
+	}
+	return v
+}
+
+// AndThen applies `f` to the value of `v`.
+//
+// Because `f` depends on the value of `v`, only the errors of `v`
+// are reported if it is invalid. This is the only combinator in this
+// package that does not accumulate errors.
+func AndThen[A any, B any](v Validated[A], f func(value A) Validated[B]) Validated[B] {
+	if v.err != nil {
+		return Invalid[B](v.err)
+	}
+	return f(v.value)
+}
+
+// ====================
+// :: Error policies ::
+// ====================
+
+// ErrorSemigroup combines errors using `errors.Join`.
+// It is used by all combinators without a `With` suffix.
+//
+// When the combinators accumulate more than two errors with it, they join
+// them at once, so that `Unwrap() []error` returns all of them in order.
+func ErrorSemigroup() functional.Semigroup[error] {
+	return errorSemigroup{}
+}
+
+type errorSemigroup struct{}
+
+func (errorSemigroup) Combine(x error, y error) error {
+	return errors.Join(x, y)
+}
+
+// combineErrors combines the non-nil errors in `errs` using `policy`,
+// or returns nil if there are none.
+func combineErrors(policy functional.Semigroup[error], errs ...error) error {
+	if _, ok := policy.(errorSemigroup); ok {
+		var nonNil []error
+		for _, err := range errs {
+			if err != nil {
+				nonNil = append(nonNil, err)
+			}
+		}
+		if len(nonNil) == 1 {
+			return nonNil[0]
+		}
+		return errors.Join(nonNil...)
+	}
+	var r error
+	for _, err := range errs {
+		switch {
+		case err == nil:
+		case r == nil:
+			r = err
+		default:
+			r = policy.Combine(r, err)
+		}
+	}
+	return r
+}
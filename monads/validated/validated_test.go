@@ -0,0 +1,128 @@
+package validated
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	functional "github.com/cr7pt0gr4ph7/functional-go"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+)
+
+type user struct {
+	name  string
+	email string
+	age   int
+}
+
+func validateName(name string) Validated[string] {
+	if name == "" {
+		return Invalid[string](errors.New("name is empty"))
+	}
+	return Valid(name)
+}
+
+func validateEmail(email string) Validated[string] {
+	if !strings.Contains(email, "@") {
+		return Invalid[string](errors.New("email is invalid"))
+	}
+	return Valid(email)
+}
+
+func validateAge(age int) Validated[int] {
+	if age < 0 {
+		return Invalid[int](errors.New("age is negative"))
+	}
+	return Valid(age)
+}
+
+func newUser(name string, email string, age int) Validated[user] {
+	return Map3(validateName(name), validateEmail(email), validateAge(age),
+		func(name string, email string, age int) user { return user{name, email, age} })
+}
+
+func ExampleMap3() {
+	fmt.Println(newUser("alice", "alice@example.com", 30).Extract())
+	_, err := newUser("", "nope", -1).Extract()
+	fmt.Println(err)
+	// Output:
+	// {alice alice@example.com 30} <nil>
+	// name is empty
+	// email is invalid
+	// age is negative
+}
+
+func ExampleTraverseWith() {
+	// Keep only the first error.
+	first := functional.FirstSemigroup[error]()
+	_, err := TraverseWith(first, []int{1, -2, -3}, validateAge).Extract()
+	fmt.Println(err)
+	// Output: age is negative
+}
+
+func TestTraverseCollectsAllErrors(t *testing.T) {
+	errA, errB := errors.New("a"), errors.New("b")
+	r := Traverse([]error{nil, errA, nil, errB}, func(err error) Validated[int] {
+		return From(1, err)
+	})
+	if _, err := r.Extract(); !errors.Is(err, errA) || !errors.Is(err, errB) {
+		t.Errorf("expected both errors, got %v", err)
+	}
+
+	ok := Sequence([]Validated[int]{Valid(1), Valid(2)})
+	if v, err := ok.Extract(); err != nil || len(v) != 2 {
+		t.Errorf("expected [1 2], got %v, %v", v, err)
+	}
+}
+
+func TestTraverseMap(t *testing.T) {
+	r := TraverseMap(map[string]int{"x": 1, "y": -1, "z": -2}, func(key string, age int) Validated[int] {
+		return MapError(validateAge(age), func(err error) error {
+			return fmt.Errorf("%s: %w", key, err)
+		})
+	})
+	_, err := r.Extract()
+	if err == nil || !strings.Contains(err.Error(), "y: ") || !strings.Contains(err.Error(), "z: ") {
+		t.Errorf("expected errors for y and z, got %v", err)
+	}
+
+	ok := TraverseMap(map[string]int{"x": 1}, func(_ string, age int) Validated[int] {
+		return validateAge(age)
+	})
+	if v, err := ok.Extract(); err != nil || v["x"] != 1 {
+		t.Errorf("expected map[x:1], got %v, %v", v, err)
+	}
+}
+
+func TestResultRoundTrip(t *testing.T) {
+	err := errors.New("failed")
+	for _, r := range []result.Result[int]{result.Ok(1), result.Error[int](err), result.From(2, err)} {
+		if back := ToResult(FromResult(r)); back != r {
+			t.Errorf("round trip changed %v into %v", r, back)
+		}
+	}
+}
+
+func TestErrorsAreFlat(t *testing.T) {
+	errs := []error{errors.New("a"), errors.New("b"), errors.New("c")}
+	_, err := Traverse(errs, func(err error) Validated[int] {
+		return Invalid[int](err)
+	}).Extract()
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok || len(joined.Unwrap()) != 3 {
+		t.Errorf("expected 3 joined errors, got %v", err)
+	}
+
+	_, err = Map3(Invalid[int](errs[0]), Invalid[int](errs[1]), Invalid[int](errs[2]), func(a, b, c int) int {
+		return a + b + c
+	}).Extract()
+	joined, ok = err.(interface{ Unwrap() []error })
+	if !ok || len(joined.Unwrap()) != 3 {
+		t.Errorf("expected 3 joined errors, got %v", err)
+	}
+
+	if Invalid[int](nil).Error() != result.Error[int](nil).Error() {
+		t.Error("expected Invalid(nil) to use the same error as result.Error(nil)")
+	}
+}
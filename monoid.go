@@ -1,5 +1,9 @@
 package functional
 
+import (
+	"golang.org/x/exp/constraints"
+)
+
 type canAdd interface {
 	constraints.Complex | constraints.Integer | constraints.Float | string
 }
@@ -0,0 +1,337 @@
+package functional
+
+import (
+	"github.com/cr7pt0gr4ph7/functional-go/collections/maps"
+	"github.com/cr7pt0gr4ph7/functional-go/funcs"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+	"github.com/cr7pt0gr4ph7/functional-go/tuple"
+	"golang.org/x/exp/constraints"
+)
+
+// ======================
+// :: Custom instances ::
+// ======================
+
+// NewSemigroup returns a Semigroup that uses `combine` to combine values.
+//
+// The caller is responsible for ensuring that `combine` is associative.
+func NewSemigroup[A any](combine func(x A, y A) A) Semigroup[A] {
+	return funcSemigroup[A]{combine: combine}
+}
+
+// NewMonoid returns a Monoid that uses `combine` to combine values
+// and `empty` as the identity element.
+//
+// The caller is responsible for ensuring that `combine` is associative
+// and that `empty` actually is an identity element for `combine`.
+func NewMonoid[A any](empty A, combine func(x A, y A) A) Monoid[A] {
+	return funcMonoid[A]{funcSemigroup[A]{combine}, empty}
+}
+
+type funcSemigroup[A any] struct {
+	combine func(x A, y A) A
+}
+
+func (s funcSemigroup[A]) Combine(x A, y A) A {
+	return s.combine(x, y)
+}
+
+type funcMonoid[A any] struct {
+	funcSemigroup[A]
+	empty A
+}
+
+func (m funcMonoid[A]) Empty() A {
+	return m.empty
+}
+
+// =======================
+// :: Numeric instances ::
+// =======================
+
+// SumMonoid combines numbers by adding them.
+func SumMonoid[A funcs.Numeric]() Monoid[A] {
+	return nativeSemigroup[A]{}
+}
+
+// ProductMonoid combines numbers by multiplying them.
+func ProductMonoid[A funcs.Numeric]() Monoid[A] {
+	return productMonoid[A]{}
+}
+
+type productMonoid[A funcs.Numeric] struct{}
+
+func (_ productMonoid[A]) Combine(x A, y A) A {
+	return x * y
+}
+
+func (_ productMonoid[A]) Empty() A {
+	return A(1)
+}
+
+// SumMonoidOf is like `SumMonoid`, but uses the operations of `num`.
+func SumMonoidOf[A any](num funcs.Num[A]) Monoid[A] {
+	return NewMonoid(num.Zero(), num.Add)
+}
+
+// ProductMonoidOf is like `ProductMonoid`, but uses the operations of `num`.
+func ProductMonoidOf[A any](num funcs.Num[A]) Monoid[A] {
+	return NewMonoid(num.One(), num.Mul)
+}
+
+// MinSemigroup combines values by keeping the smaller one.
+//
+// Use `OptionMonoid(MinSemigroup[A]())` to obtain a Monoid.
+func MinSemigroup[A constraints.Ordered]() Semigroup[A] {
+	return minSemigroup[A]{}
+}
+
+type minSemigroup[A constraints.Ordered] struct{}
+
+func (_ minSemigroup[A]) Combine(x A, y A) A {
+	if y < x {
+		return y
+	}
+	return x
+}
+
+// MaxSemigroup combines values by keeping the larger one.
+//
+// Use `OptionMonoid(MaxSemigroup[A]())` to obtain a Monoid.
+func MaxSemigroup[A constraints.Ordered]() Semigroup[A] {
+	return maxSemigroup[A]{}
+}
+
+type maxSemigroup[A constraints.Ordered] struct{}
+
+func (_ maxSemigroup[A]) Combine(x A, y A) A {
+	if x < y {
+		return y
+	}
+	return x
+}
+
+// ==========================
+// :: Positional instances ::
+// ==========================
+
+// FirstSemigroup combines values by keeping the left one.
+func FirstSemigroup[A any]() Semigroup[A] {
+	return firstSemigroup[A]{}
+}
+
+type firstSemigroup[A any] struct{}
+
+func (_ firstSemigroup[A]) Combine(x A, _ A) A {
+	return x
+}
+
+// LastSemigroup combines values by keeping the right one.
+func LastSemigroup[A any]() Semigroup[A] {
+	return lastSemigroup[A]{}
+}
+
+type lastSemigroup[A any] struct{}
+
+func (_ lastSemigroup[A]) Combine(_ A, y A) A {
+	return y
+}
+
+// FirstMonoid keeps the leftmost present value.
+func FirstMonoid[A any]() Monoid[option.Optional[A]] {
+	return OptionMonoid(FirstSemigroup[A]())
+}
+
+// LastMonoid keeps the rightmost present value.
+func LastMonoid[A any]() Monoid[option.Optional[A]] {
+	return OptionMonoid(LastSemigroup[A]())
+}
+
+// =======================
+// :: Boolean instances ::
+// =======================
+
+// AllMonoid combines booleans using `&&`.
+func AllMonoid() Monoid[bool] {
+	return allMonoid{}
+}
+
+type allMonoid struct{}
+
+func (_ allMonoid) Combine(x bool, y bool) bool { return x && y }
+func (_ allMonoid) Empty() bool                 { return true }
+
+// AnyMonoid combines booleans using `||`.
+func AnyMonoid() Monoid[bool] {
+	return anyMonoid{}
+}
+
+type anyMonoid struct{}
+
+func (_ anyMonoid) Combine(x bool, y bool) bool { return x || y }
+func (_ anyMonoid) Empty() bool                 { return false }
+
+// ==========================
+// :: Collection instances ::
+// ==========================
+
+// StringMonoid combines strings by concatenating them.
+func StringMonoid() Monoid[string] {
+	return nativeSemigroup[string]{}
+}
+
+// SliceMonoid combines slices by concatenating them.
+//
+// The result of `Combine` is always a newly allocated slice
+// and never aliases one of its arguments.
+func SliceMonoid[A any]() Monoid[Slice[A]] {
+	return sliceMonoid[A]{}
+}
+
+type sliceMonoid[A any] struct{}
+
+func (_ sliceMonoid[A]) Combine(x Slice[A], y Slice[A]) Slice[A] {
+	r := make(Slice[A], 0, len(x)+len(y))
+	r = append(r, x...)
+	return append(r, y...)
+}
+
+func (_ sliceMonoid[A]) Empty() Slice[A] {
+	return nil
+}
+
+// MapMonoid combines maps by merging their entries.
+// Values present in both maps are combined using `inner`.
+//
+// The result of `Combine` is always a newly allocated map
+// and never aliases one of its arguments.
+func MapMonoid[K comparable, V any](inner Semigroup[V]) Monoid[maps.Map[K, V]] {
+	return mapMonoid[K, V]{inner: inner}
+}
+
+type mapMonoid[K comparable, V any] struct {
+	inner Semigroup[V]
+}
+
+func (m mapMonoid[K, V]) Combine(x maps.Map[K, V], y maps.Map[K, V]) maps.Map[K, V] {
+	r := make(maps.Map[K, V], len(x)+len(y))
+	for k, v := range x {
+		r[k] = v
+	}
+	for k, v := range y {
+		if w, ok := r[k]; ok {
+			r[k] = m.inner.Combine(w, v)
+		} else {
+			r[k] = v
+		}
+	}
+	return r
+}
+
+func (m mapMonoid[K, V]) Empty() maps.Map[K, V] {
+	return maps.Map[K, V]{}
+}
+
+// ======================
+// :: Lifted instances ::
+// ======================
+
+// OptionMonoid lifts a Semigroup to a Monoid over optional values,
+// using `None` as the identity element.
+func OptionMonoid[A any](inner Semigroup[A]) Monoid[option.Optional[A]] {
+	return optionMonoid[A]{inner: inner}
+}
+
+type optionMonoid[A any] struct {
+	inner Semigroup[A]
+}
+
+func (m optionMonoid[A]) Combine(x option.Optional[A], y option.Optional[A]) option.Optional[A] {
+	xv, xok := x.Value()
+	yv, yok := y.Value()
+	if xok && yok {
+		return option.Some(m.inner.Combine(xv, yv))
+	}
+	return x.OrElse(y)
+}
+
+func (m optionMonoid[A]) Empty() option.Optional[A] {
+	return option.None[A]()
+}
+
+// EndoMonoid combines functions `A -> A` by composing them,
+// applying the left function first.
+func EndoMonoid[A any]() Monoid[func(arg A) A] {
+	return endoMonoid[A]{}
+}
+
+type endoMonoid[A any] struct{}
+
+func (_ endoMonoid[A]) Combine(f func(arg A) A, g func(arg A) A) func(arg A) A {
+	return funcs.Compose(f, g)
+}
+
+func (_ endoMonoid[A]) Empty() func(arg A) A {
+	return funcs.Identity[A]
+}
+
+// FuncMonoid combines functions by combining their results using `inner`.
+func FuncMonoid[A any, B any](inner Monoid[B]) Monoid[func(arg A) B] {
+	return funcResultMonoid[A, B]{inner: inner}
+}
+
+type funcResultMonoid[A any, B any] struct {
+	inner Monoid[B]
+}
+
+func (m funcResultMonoid[A, B]) Combine(f func(arg A) B, g func(arg A) B) func(arg A) B {
+	return func(arg A) B {
+		return m.inner.Combine(f(arg), g(arg))
+	}
+}
+
+func (m funcResultMonoid[A, B]) Empty() func(arg A) B {
+	empty := m.inner.Empty()
+	return func(_ A) B {
+		return empty
+	}
+}
+
+// TupleMonoid2 combines values of a product type `T` componentwise.
+//
+// `split` decomposes a `T` into its components, and `join` reassembles them.
+func TupleMonoid2[T any, A any, B any](ma Monoid[A], mb Monoid[B], split func(t T) (A, B), join func(a A, b B) T) Monoid[T] {
+	return NewMonoid(join(ma.Empty(), mb.Empty()), func(x T, y T) T {
+		xa, xb := split(x)
+		ya, yb := split(y)
+		return join(ma.Combine(xa, ya), mb.Combine(xb, yb))
+	})
+}
+
+// TupleMonoid3 combines values of a product type `T` componentwise.
+//
+// `split` decomposes a `T` into its components, and `join` reassembles them.
+func TupleMonoid3[T any, A any, B any, C any](ma Monoid[A], mb Monoid[B], mc Monoid[C], split func(t T) (A, B, C), join func(a A, b B, c C) T) Monoid[T] {
+	return NewMonoid(join(ma.Empty(), mb.Empty(), mc.Empty()), func(x T, y T) T {
+		xa, xb, xc := split(x)
+		ya, yb, yc := split(y)
+		return join(ma.Combine(xa, ya), mb.Combine(xb, yb), mc.Combine(xc, yc))
+	})
+}
+
+// PairMonoid combines pairs componentwise.
+func PairMonoid[A any, B any](ma Monoid[A], mb Monoid[B]) Monoid[tuple.Pair[A, B]] {
+	return TupleMonoid2(ma, mb, tuple.Pair[A, B].Values, tuple.NewPair[A, B])
+}
+
+// TripleMonoid combines triples componentwise.
+func TripleMonoid[A any, B any, C any](ma Monoid[A], mb Monoid[B], mc Monoid[C]) Monoid[tuple.Triple[A, B, C]] {
+	return TupleMonoid3(ma, mb, mc, tuple.Triple[A, B, C].Values, tuple.NewTriple[A, B, C])
+}
+
+// QuadMonoid combines quads componentwise.
+func QuadMonoid[A any, B any, C any, D any](ma Monoid[A], mb Monoid[B], mc Monoid[C], md Monoid[D]) Monoid[tuple.Quad[A, B, C, D]] {
+	return NewMonoid(tuple.NewQuad(ma.Empty(), mb.Empty(), mc.Empty(), md.Empty()), func(x tuple.Quad[A, B, C, D], y tuple.Quad[A, B, C, D]) tuple.Quad[A, B, C, D] {
+		return tuple.NewQuad(ma.Combine(x.First, y.First), mb.Combine(x.Second, y.Second), mc.Combine(x.Third, y.Third), md.Combine(x.Fourth, y.Fourth))
+	})
+}
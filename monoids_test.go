@@ -0,0 +1,54 @@
+package functional
+
+import (
+	"fmt"
+
+	"github.com/cr7pt0gr4ph7/functional-go/collections/maps"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+	"github.com/cr7pt0gr4ph7/functional-go/tuple"
+)
+
+func ExampleFoldMap() {
+	s := Slice[string]{"a", "bb", "ccc"}
+	fmt.Println(FoldMap(s, SumMonoid[int](), func(x string) int { return len(x) }))
+	fmt.Println(FoldMap(s, ProductMonoid[int](), func(x string) int { return len(x) }))
+	fmt.Println(FoldMap(s, OptionMonoid(MaxSemigroup[string]()), option.Some[string]).Value())
+	fmt.Println(FoldMap(s, AllMonoid(), func(x string) bool { return len(x) < 3 }))
+	fmt.Println(Fold(s, StringMonoid()))
+	// Output:
+	// 6
+	// 6
+	// ccc true
+	// false
+	// abbccc
+}
+
+func ExampleMapMonoid() {
+	m := MapMonoid[string, int](SumMonoid[int]())
+	x := maps.Map[string, int]{"a": 1, "b": 2}
+	y := maps.Map[string, int]{"b": 3, "c": 4}
+	fmt.Println(m.Combine(x, y))
+	// Output: map[a:1 b:5 c:4]
+}
+
+func ExampleTupleMonoid2() {
+	type stats struct {
+		count int
+		total float64
+	}
+	m := TupleMonoid2(SumMonoid[int](), SumMonoid[float64](),
+		func(s stats) (int, float64) { return s.count, s.total },
+		func(count int, total float64) stats { return stats{count, total} })
+	r := FoldMap(Slice[float64]{1.5, 2.5, 5}, m, func(x float64) stats { return stats{1, x} })
+	fmt.Println(r.count, r.total)
+	// Output: 3 9
+}
+
+func ExamplePairMonoid() {
+	m := PairMonoid(SumMonoid[int](), StringMonoid())
+	r := FoldMap(Slice[string]{"a", "bb", "ccc"}, m, func(x string) tuple.Pair[int, string] {
+		return tuple.NewPair(len(x), x)
+	})
+	fmt.Println(r)
+	// Output: {6 abbccc}
+}
@@ -0,0 +1,218 @@
+package functional
+
+import (
+	"iter"
+
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/cursor"
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/list"
+	"github.com/cr7pt0gr4ph7/functional-go/eq"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+)
+
+// ===================
+// :: NonEmptySlice ::
+// ===================
+
+// NonEmptySlice is a slice that is guaranteed to contain at least one element,
+// which allows operations like `Head` and `Reduce` to be total.
+//
+// The zero value is not valid; use `NewNonEmptySlice` or `NonEmptySliceFrom`.
+type NonEmptySlice[A any] struct {
+	s Slice[A]
+}
+
+// NewNonEmptySlice returns a NonEmptySlice containing `head` followed by `tail`.
+func NewNonEmptySlice[A any](head A, tail ...A) NonEmptySlice[A] {
+	s := make(Slice[A], 0, len(tail)+1)
+	s = append(s, head)
+	return NonEmptySlice[A]{append(s, tail...)}
+}
+
+// NonEmptySliceFrom returns `s` as a NonEmptySlice, or `None` if `s` is empty.
+//
+// The result shares its backing array with `s`.
+func NonEmptySliceFrom[A any](s []A) option.Optional[NonEmptySlice[A]] {
+	if len(s) == 0 {
+		return option.None[NonEmptySlice[A]]()
+	}
+	return option.Some(NonEmptySlice[A]{s})
+}
+
+// Head returns the first element.
+func (s NonEmptySlice[A]) Head() A {
+	return s.s[0]
+}
+
+// Last returns the last element.
+func (s NonEmptySlice[A]) Last() A {
+	return s.s[len(s.s)-1]
+}
+
+// Tail returns all elements except the first one.
+func (s NonEmptySlice[A]) Tail() Slice[A] {
+	return s.s[1:]
+}
+
+// Len returns the number of elements, which is always at least 1.
+func (s NonEmptySlice[A]) Len() int {
+	return len(s.s)
+}
+
+// Slice returns the elements as a Slice, sharing the backing array.
+func (s NonEmptySlice[A]) Slice() Slice[A] {
+	return s.s
+}
+
+// Reduce combines all elements from left to right using `semigroup`.
+func (s NonEmptySlice[A]) Reduce(semigroup Semigroup[A]) A {
+	acc := s.s[0]
+	for _, elem := range s.s[1:] {
+		acc = semigroup.Combine(acc, elem)
+	}
+	return acc
+}
+
+// Max returns the largest element according to `ord`.
+// If there are several largest elements, the first one is returned.
+func (s NonEmptySlice[A]) Max(ord eq.Ord[A]) A {
+	return s.Reduce(maxBy(ord))
+}
+
+// Min returns the smallest element according to `ord`.
+// If there are several smallest elements, the first one is returned.
+func (s NonEmptySlice[A]) Min(ord eq.Ord[A]) A {
+	return s.Reduce(minBy(ord))
+}
+
+func (s NonEmptySlice[A]) FoldLeft(fn FoldLeftFn[A]) {
+	s.s.FoldLeft(fn)
+}
+
+func (s NonEmptySlice[A]) Cursor() cursor.Cursor[A] {
+	return s.s.Cursor()
+}
+
+// Seq returns an iterator over the elements.
+func (s NonEmptySlice[A]) Seq() iter.Seq[A] {
+	return s.s.Seq()
+}
+
+// ==================
+// :: NonEmptyList ::
+// ==================
+
+// NonEmptyList is an immutable list that is guaranteed to contain at least one element,
+// which allows operations like `Head` and `Reduce` to be total.
+//
+// The zero value is not valid; use `NewNonEmptyList` or `NonEmptyListFrom`.
+type NonEmptyList[A any] struct {
+	head A
+	tail list.List[A]
+}
+
+// NewNonEmptyList returns a NonEmptyList containing `head` followed by `tail`.
+func NewNonEmptyList[A any](head A, tail ...A) NonEmptyList[A] {
+	return NonEmptyList[A]{head, list.New(tail...)}
+}
+
+// NonEmptyListFrom returns `l` as a NonEmptyList, or `None` if `l` is empty.
+func NonEmptyListFrom[A any](l list.List[A]) option.Optional[NonEmptyList[A]] {
+	head, tail, ok := l.Pop()
+	if !ok {
+		return option.None[NonEmptyList[A]]()
+	}
+	return option.Some(NonEmptyList[A]{head, tail})
+}
+
+// Head returns the first element.
+func (l NonEmptyList[A]) Head() A {
+	return l.head
+}
+
+// Last returns the last element. This takes O(n) time.
+func (l NonEmptyList[A]) Last() A {
+	last := l.head
+	for elem, next, ok := l.tail.Cursor().Advance(); ok; elem, next, ok = next.Advance() {
+		last = elem
+	}
+	return last
+}
+
+// Tail returns all elements except the first one.
+func (l NonEmptyList[A]) Tail() list.List[A] {
+	return l.tail
+}
+
+// Len returns the number of elements, which is always at least 1.
+func (l NonEmptyList[A]) Len() int {
+	return 1 + l.tail.Len()
+}
+
+// Prepend returns a new NonEmptyList with `item` in front of the elements of `l`.
+func (l NonEmptyList[A]) Prepend(item A) NonEmptyList[A] {
+	return NonEmptyList[A]{item, l.List()}
+}
+
+// List returns the elements as a `list.List`.
+func (l NonEmptyList[A]) List() list.List[A] {
+	return l.tail.Prepend(l.head)
+}
+
+// Reduce combines all elements from left to right using `semigroup`.
+func (l NonEmptyList[A]) Reduce(semigroup Semigroup[A]) A {
+	acc := l.head
+	for elem, next, ok := l.tail.Cursor().Advance(); ok; elem, next, ok = next.Advance() {
+		acc = semigroup.Combine(acc, elem)
+	}
+	return acc
+}
+
+// Max returns the largest element according to `ord`.
+// If there are several largest elements, the first one is returned.
+func (l NonEmptyList[A]) Max(ord eq.Ord[A]) A {
+	return l.Reduce(maxBy(ord))
+}
+
+// Min returns the smallest element according to `ord`.
+// If there are several smallest elements, the first one is returned.
+func (l NonEmptyList[A]) Min(ord eq.Ord[A]) A {
+	return l.Reduce(minBy(ord))
+}
+
+func (l NonEmptyList[A]) FoldLeft(fn FoldLeftFn[A]) {
+	fn.Next(l.head)
+	for elem, next, ok := l.tail.Cursor().Advance(); ok; elem, next, ok = next.Advance() {
+		fn.Next(elem)
+	}
+}
+
+func (l NonEmptyList[A]) Cursor() cursor.Cursor[A] {
+	return l.List().Cursor()
+}
+
+// Seq returns an iterator over the elements.
+func (l NonEmptyList[A]) Seq() iter.Seq[A] {
+	return cursor.Seq(l.Cursor())
+}
+
+// =============
+// :: Helpers ::
+// =============
+
+func maxBy[A any](ord eq.Ord[A]) Semigroup[A] {
+	return NewSemigroup(func(x A, y A) A {
+		if ord.Compare(x, y) < 0 {
+			return y
+		}
+		return x
+	})
+}
+
+func minBy[A any](ord eq.Ord[A]) Semigroup[A] {
+	return NewSemigroup(func(x A, y A) A {
+		if ord.Compare(y, x) < 0 {
+			return y
+		}
+		return x
+	})
+}
@@ -0,0 +1,66 @@
+package functional
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/list"
+	"github.com/cr7pt0gr4ph7/functional-go/eq"
+)
+
+func ExampleNonEmptySlice() {
+	s := NewNonEmptySlice(3, 1, 4, 1, 5)
+	fmt.Println(s.Head(), s.Last(), s.Tail())
+	fmt.Println(s.Reduce(SumMonoid[int]()))
+	fmt.Println(s.Min(eq.Ordered[int]()), s.Max(eq.Ordered[int]()))
+	// Output:
+	// 3 5 [1 4 1 5]
+	// 14
+	// 1 5
+}
+
+func ExampleNonEmptySliceFrom() {
+	fmt.Println(NonEmptySliceFrom([]string{}).IsPresent())
+	if s, ok := NonEmptySliceFrom([]string{"a", "b"}).Value(); ok {
+		fmt.Println(s.Reduce(StringMonoid()))
+	}
+	// Output:
+	// false
+	// ab
+}
+
+func ExampleNonEmptyList() {
+	l := NewNonEmptyList("b", "c").Prepend("a")
+	fmt.Println(l.Head(), l.Last(), l.Len())
+	fmt.Println(l.Reduce(StringMonoid()))
+	fmt.Println(ToSlice[Foldable[string]](l))
+	// Output:
+	// a c 3
+	// abc
+	// [a b c]
+}
+
+func TestNonEmptyListFrom(t *testing.T) {
+	if NonEmptyListFrom(list.Empty[int]()).IsPresent() {
+		t.Errorf("expected None for an empty list")
+	}
+	l, ok := NonEmptyListFrom(list.New(1, 2, 3)).Value()
+	if !ok {
+		t.Fatalf("expected Some for a non-empty list")
+	}
+	if l.Head() != 1 || l.Last() != 3 || l.Tail().Len() != 2 {
+		t.Errorf("unexpected list %v", ToSlice[Foldable[int]](l))
+	}
+}
+
+func TestNonEmptyMinMaxReturnsFirst(t *testing.T) {
+	type pair struct{ key, index int }
+	byKey := eq.OrdBy(eq.Ordered[int](), func(p pair) int { return p.key })
+	s := NewNonEmptySlice(pair{1, 0}, pair{2, 1}, pair{1, 2}, pair{2, 3})
+	if m := s.Min(byKey); m.index != 0 {
+		t.Errorf("expected first smallest element, got %v", m)
+	}
+	if m := s.Max(byKey); m.index != 1 {
+		t.Errorf("expected first largest element, got %v", m)
+	}
+}
@@ -0,0 +1,211 @@
+package numeric
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+)
+
+// Decimal is a fixed-point number with `Scale()` fractional digits,
+// represented as an arbitrary-precision integer multiple of `10^-Scale()`.
+//
+// Addition, subtraction and multiplication are exact, and the scale of the
+// result is large enough to hold it. Division and `Round` take an explicit
+// scale and rounding mode. Values with different scales may be equal,
+// e.g. "1.5" and "1.50".
+type Decimal struct {
+	unscaled *big.Int // nil represents zero; never modified after construction
+	scale    int
+}
+
+func checkScale(scale int) {
+	if scale < 0 {
+		panic(fmt.Sprintf("numeric: negative scale %d", scale))
+	}
+}
+
+// NewDecimal returns `unscaled * 10^-scale`. Panics if `scale` is negative.
+//
+// For example, `NewDecimal(1999, 2)` is 19.99.
+func NewDecimal(unscaled int64, scale int) Decimal {
+	checkScale(scale)
+	return Decimal{big.NewInt(unscaled), scale}
+}
+
+// DecimalFromInt returns `n` as a Decimal with scale 0.
+func DecimalFromInt(n int64) Decimal {
+	return Decimal{big.NewInt(n), 0}
+}
+
+// DecimalFromBig returns a copy of `unscaled * 10^-scale`. Panics if `scale` is negative.
+func DecimalFromBig(unscaled *big.Int, scale int) Decimal {
+	checkScale(scale)
+	return Decimal{new(big.Int).Set(unscaled), scale}
+}
+
+// ParseDecimal parses a decimal number like "-12.345".
+// The scale of the result is the number of digits after the decimal point.
+func ParseDecimal(s string) result.Result[Decimal] {
+	invalid := func() result.Result[Decimal] {
+		return result.Error[Decimal](fmt.Errorf("numeric: invalid decimal %q", s))
+	}
+	digits := strings.TrimLeft(s, "+-")
+	if len(s)-len(digits) > 1 {
+		return invalid()
+	}
+	intPart, fracPart, _ := strings.Cut(digits, ".")
+	if (intPart == "" && fracPart == "") || strings.ContainsFunc(intPart+fracPart, func(c rune) bool { return c < '0' || c > '9' }) {
+		return invalid()
+	}
+	unscaled, ok := new(big.Int).SetString(intPart+fracPart, 10)
+	if !ok {
+		return invalid()
+	}
+	if strings.HasPrefix(s, "-") {
+		unscaled.Neg(unscaled)
+	}
+	return result.Ok(Decimal{unscaled, len(fracPart)})
+}
+
+func (x Decimal) int() *big.Int {
+	if x.unscaled == nil {
+		return new(big.Int)
+	}
+	return x.unscaled
+}
+
+// Scale returns the number of fractional digits of `x`.
+func (x Decimal) Scale() int {
+	return x.scale
+}
+
+// Unscaled returns the integer `n` such that `x` is `n * 10^-x.Scale()`.
+func (x Decimal) Unscaled() *big.Int {
+	return new(big.Int).Set(x.int())
+}
+
+func (x Decimal) Sign() int {
+	return x.int().Sign()
+}
+
+func (x Decimal) IsZero() bool {
+	return x.Sign() == 0
+}
+
+// rescale returns the unscaled value of `x` at a scale of at least `x.scale`.
+func (x Decimal) rescale(scale int) *big.Int {
+	if scale == x.scale {
+		return x.int()
+	}
+	return new(big.Int).Mul(x.int(), pow10(scale-x.scale))
+}
+
+// Cmp returns -1, 0 or +1 depending on whether `x` is less than, equal to or greater than `y`.
+func (x Decimal) Cmp(y Decimal) int {
+	scale := max(x.scale, y.scale)
+	return x.rescale(scale).Cmp(y.rescale(scale))
+}
+
+// Equal reports whether `x` and `y` represent the same number, regardless of their scales.
+func (x Decimal) Equal(y Decimal) bool {
+	return x.Cmp(y) == 0
+}
+
+// Add returns `x + y` with the larger of both scales.
+func (x Decimal) Add(y Decimal) Decimal {
+	scale := max(x.scale, y.scale)
+	return Decimal{new(big.Int).Add(x.rescale(scale), y.rescale(scale)), scale}
+}
+
+// Sub returns `x - y` with the larger of both scales.
+func (x Decimal) Sub(y Decimal) Decimal {
+	scale := max(x.scale, y.scale)
+	return Decimal{new(big.Int).Sub(x.rescale(scale), y.rescale(scale)), scale}
+}
+
+// Mul returns `x * y` with the sum of both scales.
+func (x Decimal) Mul(y Decimal) Decimal {
+	return Decimal{new(big.Int).Mul(x.int(), y.int()), x.scale + y.scale}
+}
+
+// Div returns `x / y` rounded to `scale` fractional digits using `mode`,
+// or an error wrapping `ErrDivisionByZero`.
+func (x Decimal) Div(y Decimal, scale int, mode RoundingMode) result.Result[Decimal] {
+	checkScale(scale)
+	if y.IsZero() {
+		return result.Error[Decimal](fmt.Errorf("%w: %v / %v", ErrDivisionByZero, x, y))
+	}
+	// x/y = (xu * 10^-xs) / (yu * 10^-ys), so the unscaled result
+	// is (xu * 10^(scale + ys - xs)) / yu.
+	n, d := new(big.Int).Set(x.int()), new(big.Int).Set(y.int())
+	if e := scale + y.scale - x.scale; e >= 0 {
+		n.Mul(n, pow10(e))
+	} else {
+		d.Mul(d, pow10(-e))
+	}
+	if d.Sign() < 0 {
+		n.Neg(n)
+		d.Neg(d)
+	}
+	return result.Ok(Decimal{roundQuo(n, d, mode), scale})
+}
+
+func (x Decimal) Neg() Decimal {
+	return Decimal{new(big.Int).Neg(x.int()), x.scale}
+}
+
+func (x Decimal) Abs() Decimal {
+	return Decimal{new(big.Int).Abs(x.int()), x.scale}
+}
+
+// Round returns `x` with `scale` fractional digits, rounded using `mode`
+// if `scale` is smaller than the scale of `x`.
+func (x Decimal) Round(scale int, mode RoundingMode) Decimal {
+	checkScale(scale)
+	if scale >= x.scale {
+		return Decimal{x.rescale(scale), scale}
+	}
+	return Decimal{roundQuo(x.int(), pow10(x.scale-scale), mode), scale}
+}
+
+// Rational returns `x` as an exact Rational.
+func (x Decimal) Rational() Rational {
+	return Rational{new(big.Rat).SetFrac(x.int(), pow10(x.scale))}
+}
+
+// Float64 returns the nearest float64 value of `x`.
+func (x Decimal) Float64() float64 {
+	return x.Rational().Float64()
+}
+
+// String formats `x` with exactly `x.Scale()` fractional digits.
+func (x Decimal) String() string {
+	digits := new(big.Int).Abs(x.int()).String()
+	if len(digits) <= x.scale {
+		digits = strings.Repeat("0", x.scale-len(digits)+1) + digits
+	}
+	var b strings.Builder
+	if x.Sign() < 0 {
+		b.WriteByte('-')
+	}
+	b.WriteString(digits[:len(digits)-x.scale])
+	if x.scale > 0 {
+		b.WriteByte('.')
+		b.WriteString(digits[len(digits)-x.scale:])
+	}
+	return b.String()
+}
+
+func (x Decimal) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+func (x *Decimal) UnmarshalText(text []byte) error {
+	d, err := ParseDecimal(string(text)).Extract()
+	if err == nil {
+		*x = d
+	}
+	return err
+}
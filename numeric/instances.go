@@ -0,0 +1,95 @@
+package numeric
+
+import (
+	functional "github.com/cr7pt0gr4ph7/functional-go"
+	"github.com/cr7pt0gr4ph7/functional-go/eq"
+	"github.com/cr7pt0gr4ph7/functional-go/funcs"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+)
+
+// ==============
+// :: Rational ::
+// ==============
+
+// RationalNum returns the Fractional instance for Rational.
+func RationalNum() funcs.Fractional[Rational] {
+	return rationalNum{}
+}
+
+type rationalNum struct{}
+
+func (_ rationalNum) Zero() Rational                                     { return Rational{} }
+func (_ rationalNum) One() Rational                                      { return RationalFromInt(1) }
+func (_ rationalNum) Add(x Rational, y Rational) Rational                { return x.Add(y) }
+func (_ rationalNum) Sub(x Rational, y Rational) Rational                { return x.Sub(y) }
+func (_ rationalNum) Mul(x Rational, y Rational) Rational                { return x.Mul(y) }
+func (_ rationalNum) Negate(x Rational) Rational                         { return x.Neg() }
+func (_ rationalNum) Div(x Rational, y Rational) result.Result[Rational] { return x.Div(y) }
+
+// RationalOrd orders Rationals by their value.
+func RationalOrd() eq.Ord[Rational] {
+	return eq.NewOrd(Rational.Cmp)
+}
+
+// RationalSumMonoid combines Rationals by adding them.
+func RationalSumMonoid() functional.Monoid[Rational] {
+	return functional.SumMonoidOf[Rational](RationalNum())
+}
+
+// RationalProductMonoid combines Rationals by multiplying them.
+func RationalProductMonoid() functional.Monoid[Rational] {
+	return functional.ProductMonoidOf[Rational](RationalNum())
+}
+
+// =============
+// :: Decimal ::
+// =============
+
+// DecimalNum returns the Num instance for Decimal.
+//
+// Since division needs a scale and a rounding mode,
+// use `DecimalFractional` to obtain a Fractional instance.
+func DecimalNum() funcs.Num[Decimal] {
+	return decimalNum{}
+}
+
+type decimalNum struct{}
+
+func (_ decimalNum) Zero() Decimal                    { return Decimal{} }
+func (_ decimalNum) One() Decimal                     { return DecimalFromInt(1) }
+func (_ decimalNum) Add(x Decimal, y Decimal) Decimal { return x.Add(y) }
+func (_ decimalNum) Sub(x Decimal, y Decimal) Decimal { return x.Sub(y) }
+func (_ decimalNum) Mul(x Decimal, y Decimal) Decimal { return x.Mul(y) }
+func (_ decimalNum) Negate(x Decimal) Decimal         { return x.Neg() }
+
+// DecimalFractional returns a Fractional instance for Decimal
+// that rounds quotients to `scale` fractional digits using `mode`.
+func DecimalFractional(scale int, mode RoundingMode) funcs.Fractional[Decimal] {
+	checkScale(scale)
+	return decimalFractional{scale: scale, mode: mode}
+}
+
+type decimalFractional struct {
+	decimalNum
+	scale int
+	mode  RoundingMode
+}
+
+func (f decimalFractional) Div(x Decimal, y Decimal) result.Result[Decimal] {
+	return x.Div(y, f.scale, f.mode)
+}
+
+// DecimalOrd orders Decimals by their value, regardless of their scales.
+func DecimalOrd() eq.Ord[Decimal] {
+	return eq.NewOrd(Decimal.Cmp)
+}
+
+// DecimalSumMonoid combines Decimals by adding them.
+func DecimalSumMonoid() functional.Monoid[Decimal] {
+	return functional.SumMonoidOf(DecimalNum())
+}
+
+// DecimalProductMonoid combines Decimals by multiplying them.
+func DecimalProductMonoid() functional.Monoid[Decimal] {
+	return functional.ProductMonoidOf(DecimalNum())
+}
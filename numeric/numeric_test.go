@@ -0,0 +1,127 @@
+package numeric
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	functional "github.com/cr7pt0gr4ph7/functional-go"
+	"github.com/cr7pt0gr4ph7/functional-go/funcs"
+)
+
+func ExampleDecimal() {
+	price := ParseDecimal("19.99").MustBeValue()
+	total := price.Mul(DecimalFromInt(3))
+	vat := total.Mul(NewDecimal(19, 2)).Round(2, RoundHalfUp)
+	fmt.Println(total, vat, total.Add(vat))
+	fmt.Println(NewDecimal(10, 0).Div(DecimalFromInt(3), 4, RoundHalfEven).MustBeValue())
+	// Output:
+	// 59.97 11.39 71.36
+	// 3.3333
+}
+
+func ExampleRational() {
+	third := NewRational(1, 3)
+	sum := functional.SumMonoidOf[Rational](RationalNum())
+	fmt.Println(sum.Combine(third, NewRational(1, 6)), third.Format(3, RoundHalfEven))
+	_, err := third.Div(Rational{}).Extract()
+	fmt.Println(err)
+	// Output:
+	// 1/2 0.333
+	// numeric: division by zero: 1/3 / 0
+}
+
+func ExampleDecimalSumMonoid() {
+	amounts := functional.Slice[Decimal]{NewDecimal(1, 1), NewDecimal(2, 2), NewDecimal(3, 3)}
+	fmt.Println(functional.Fold(amounts, DecimalSumMonoid()))
+	fmt.Println(funcs.TimesWith(DecimalNum(), NewDecimal(15, 1))(DecimalFromInt(4)))
+	// Output:
+	// 0.123
+	// 6.0
+}
+
+func TestRoundingModes(t *testing.T) {
+	modes := []RoundingMode{RoundHalfEven, RoundHalfUp, RoundHalfDown, RoundUp, RoundDown, RoundCeiling, RoundFloor}
+	// Expected results for each mode, in the order of `modes`.
+	cases := map[string][7]string{
+		"5.5":  {"6", "6", "5", "6", "5", "6", "5"},
+		"2.5":  {"2", "3", "2", "3", "2", "3", "2"},
+		"1.6":  {"2", "2", "2", "2", "1", "2", "1"},
+		"1.1":  {"1", "1", "1", "2", "1", "2", "1"},
+		"1.0":  {"1", "1", "1", "1", "1", "1", "1"},
+		"-1.0": {"-1", "-1", "-1", "-1", "-1", "-1", "-1"},
+		"-1.1": {"-1", "-1", "-1", "-2", "-1", "-1", "-2"},
+		"-1.6": {"-2", "-2", "-2", "-2", "-1", "-1", "-2"},
+		"-2.5": {"-2", "-3", "-2", "-3", "-2", "-2", "-3"},
+		"-5.5": {"-6", "-6", "-5", "-6", "-5", "-5", "-6"},
+	}
+	for input, want := range cases {
+		d := ParseDecimal(input).MustBeValue()
+		for i, mode := range modes {
+			if got := d.Round(0, mode).String(); got != want[i] {
+				t.Errorf("%s rounded with %v: got %s, want %s", input, mode, got, want[i])
+			}
+			if got := d.Rational().Round(mode).String(); got != want[i] {
+				t.Errorf("rational %s rounded with %v: got %s, want %s", input, mode, got, want[i])
+			}
+		}
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	valid := map[string]string{
+		"0":       "0",
+		"-0.50":   "-0.50",
+		"+12.345": "12.345",
+		".5":      "0.5",
+		"7.":      "7",
+		"-0.001":  "-0.001",
+	}
+	for input, want := range valid {
+		d, err := ParseDecimal(input).Extract()
+		if err != nil || d.String() != want {
+			t.Errorf("ParseDecimal(%q): got (%v, %v), want %s", input, d, err, want)
+		}
+	}
+	for _, input := range []string{"", "-", ".", "1.2.3", "--1", "1e3", "abc", " 1"} {
+		if err := ParseDecimal(input).Error(); err == nil {
+			t.Errorf("ParseDecimal(%q) should fail", input)
+		}
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	x, y := NewDecimal(150, 2), NewDecimal(-25, 1)
+	if got := x.Add(y).String(); got != "-1.00" {
+		t.Errorf("Add: got %s", got)
+	}
+	if got := x.Sub(y).String(); got != "4.00" {
+		t.Errorf("Sub: got %s", got)
+	}
+	if got := x.Mul(y).String(); got != "-3.750" {
+		t.Errorf("Mul: got %s", got)
+	}
+	if got := x.Div(y, 2, RoundHalfEven).MustBeValue().String(); got != "-0.60" {
+		t.Errorf("Div: got %s", got)
+	}
+	if !NewDecimal(15, 1).Equal(NewDecimal(150, 2)) || x.Cmp(y) != 1 {
+		t.Error("comparison should ignore scale")
+	}
+	if err := x.Div(Decimal{}, 2, RoundHalfEven).Error(); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("expected ErrDivisionByZero, got %v", err)
+	}
+	if got := (Decimal{}).String(); got != "0" {
+		t.Errorf("zero value: got %s", got)
+	}
+}
+
+func TestDecimalText(t *testing.T) {
+	var d Decimal
+	if err := d.UnmarshalText([]byte("-3.14")); err != nil || d.String() != "-3.14" {
+		t.Errorf("UnmarshalText: got (%v, %v)", d, err)
+	}
+	var r Rational
+	if err := r.UnmarshalText([]byte("6/8")); err != nil || r.String() != "3/4" {
+		t.Errorf("UnmarshalText: got (%v, %v)", r, err)
+	}
+}
@@ -0,0 +1,162 @@
+package numeric
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+)
+
+var ErrDivisionByZero = errors.New("numeric: division by zero")
+
+// Rational is an exact fraction of arbitrary-precision integers.
+type Rational struct {
+	r *big.Rat // nil represents zero; never modified after construction
+}
+
+// NewRational returns `num / den`. Panics if `den` is zero.
+func NewRational(num int64, den int64) Rational {
+	if den == 0 {
+		panic(ErrDivisionByZero)
+	}
+	return Rational{big.NewRat(num, den)}
+}
+
+// RationalFromInt returns `n` as a Rational.
+func RationalFromInt(n int64) Rational {
+	return Rational{new(big.Rat).SetInt64(n)}
+}
+
+// RationalFromBig returns a copy of `r` as a Rational.
+func RationalFromBig(r *big.Rat) Rational {
+	return Rational{new(big.Rat).Set(r)}
+}
+
+// ParseRational parses a fraction like "3/4" or "-5",
+// or a decimal number like "1.25" or "1e-3".
+func ParseRational(s string) result.Result[Rational] {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return result.Error[Rational](fmt.Errorf("numeric: invalid rational %q", s))
+	}
+	return result.Ok(Rational{r})
+}
+
+func (x Rational) rat() *big.Rat {
+	if x.r == nil {
+		return new(big.Rat)
+	}
+	return x.r
+}
+
+// Big returns `x` as a newly allocated `big.Rat`.
+func (x Rational) Big() *big.Rat {
+	return new(big.Rat).Set(x.rat())
+}
+
+// Num returns the numerator of `x` in lowest terms. Its sign is the sign of `x`.
+func (x Rational) Num() *big.Int {
+	return new(big.Int).Set(x.rat().Num())
+}
+
+// Denom returns the positive denominator of `x` in lowest terms.
+func (x Rational) Denom() *big.Int {
+	return new(big.Int).Set(x.rat().Denom())
+}
+
+func (x Rational) Sign() int {
+	return x.rat().Sign()
+}
+
+func (x Rational) IsZero() bool {
+	return x.Sign() == 0
+}
+
+// IsInt reports whether the denominator of `x` is 1.
+func (x Rational) IsInt() bool {
+	return x.rat().IsInt()
+}
+
+// Cmp returns -1, 0 or +1 depending on whether `x` is less than, equal to or greater than `y`.
+func (x Rational) Cmp(y Rational) int {
+	return x.rat().Cmp(y.rat())
+}
+
+func (x Rational) Equal(y Rational) bool {
+	return x.Cmp(y) == 0
+}
+
+func (x Rational) Add(y Rational) Rational {
+	return Rational{new(big.Rat).Add(x.rat(), y.rat())}
+}
+
+func (x Rational) Sub(y Rational) Rational {
+	return Rational{new(big.Rat).Sub(x.rat(), y.rat())}
+}
+
+func (x Rational) Mul(y Rational) Rational {
+	return Rational{new(big.Rat).Mul(x.rat(), y.rat())}
+}
+
+// Div returns `x / y`, or an error wrapping `ErrDivisionByZero`.
+func (x Rational) Div(y Rational) result.Result[Rational] {
+	if y.IsZero() {
+		return result.Error[Rational](fmt.Errorf("%w: %v / 0", ErrDivisionByZero, x))
+	}
+	return result.Ok(Rational{new(big.Rat).Quo(x.rat(), y.rat())})
+}
+
+// Inv returns `1 / x`, or an error wrapping `ErrDivisionByZero`.
+func (x Rational) Inv() result.Result[Rational] {
+	return RationalFromInt(1).Div(x)
+}
+
+func (x Rational) Neg() Rational {
+	return Rational{new(big.Rat).Neg(x.rat())}
+}
+
+func (x Rational) Abs() Rational {
+	return Rational{new(big.Rat).Abs(x.rat())}
+}
+
+// Round rounds `x` to an integer using `mode`.
+func (x Rational) Round(mode RoundingMode) *big.Int {
+	return roundQuo(x.rat().Num(), x.rat().Denom(), mode)
+}
+
+// ToDecimal rounds `x` to `scale` fractional digits using `mode`.
+func (x Rational) ToDecimal(scale int, mode RoundingMode) Decimal {
+	checkScale(scale)
+	n := new(big.Int).Mul(x.rat().Num(), pow10(scale))
+	return Decimal{roundQuo(n, x.rat().Denom(), mode), scale}
+}
+
+// Float64 returns the nearest float64 value of `x`.
+func (x Rational) Float64() float64 {
+	f, _ := x.rat().Float64()
+	return f
+}
+
+// String formats `x` as "num/den", or as "num" if `x` is an integer.
+func (x Rational) String() string {
+	return x.rat().RatString()
+}
+
+// Format formats `x` as a decimal number with `scale` fractional digits,
+// rounded using `mode`.
+func (x Rational) Format(scale int, mode RoundingMode) string {
+	return x.ToDecimal(scale, mode).String()
+}
+
+func (x Rational) MarshalText() ([]byte, error) {
+	return []byte(x.String()), nil
+}
+
+func (x *Rational) UnmarshalText(text []byte) error {
+	r, err := ParseRational(string(text)).Extract()
+	if err == nil {
+		*x = r
+	}
+	return err
+}
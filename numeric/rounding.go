@@ -0,0 +1,96 @@
+// Package numeric provides exact numeric types for money and ratios:
+// `Rational`, an arbitrary-precision fraction, and `Decimal`,
+// a fixed-point number with an explicit number of fractional digits.
+//
+// Both are immutable values: operations never modify their receivers
+// or arguments, and the zero value represents zero.
+package numeric
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// RoundingMode determines how a value is rounded
+// if it cannot be represented exactly.
+type RoundingMode int
+
+const (
+	// RoundHalfEven rounds to the nearest value, and ties to the even neighbour.
+	// Also known as banker's rounding.
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to the nearest value, and ties away from zero.
+	RoundHalfUp
+	// RoundHalfDown rounds to the nearest value, and ties towards zero.
+	RoundHalfDown
+	// RoundUp rounds away from zero.
+	RoundUp
+	// RoundDown rounds towards zero, i.e. truncates.
+	RoundDown
+	// RoundCeiling rounds towards positive infinity.
+	RoundCeiling
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+)
+
+func (m RoundingMode) String() string {
+	switch m {
+	case RoundHalfEven:
+		return "RoundHalfEven"
+	case RoundHalfUp:
+		return "RoundHalfUp"
+	case RoundHalfDown:
+		return "RoundHalfDown"
+	case RoundUp:
+		return "RoundUp"
+	case RoundDown:
+		return "RoundDown"
+	case RoundCeiling:
+		return "RoundCeiling"
+	case RoundFloor:
+		return "RoundFloor"
+	default:
+		return fmt.Sprintf("RoundingMode(%d)", int(m))
+	}
+}
+
+// roundQuo returns `n / d` rounded to an integer using `mode`.
+// `d` must be positive.
+func roundQuo(n *big.Int, d *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(n, d, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	sign := n.Sign()
+	r2 := new(big.Int).Abs(r)
+	half := r2.Lsh(r2, 1).Cmp(d) // compares the remainder with d/2
+
+	var away bool
+	switch mode {
+	case RoundHalfEven:
+		away = half > 0 || (half == 0 && q.Bit(0) == 1)
+	case RoundHalfUp:
+		away = half >= 0
+	case RoundHalfDown:
+		away = half > 0
+	case RoundUp:
+		away = true
+	case RoundDown:
+		away = false
+	case RoundCeiling:
+		away = sign > 0
+	case RoundFloor:
+		away = sign < 0
+	default:
+		panic(fmt.Sprintf("numeric: unknown rounding mode %v", mode))
+	}
+	if away {
+		q.Add(q, big.NewInt(int64(sign)))
+	}
+	return q
+}
+
+// pow10 returns 10 to the power of `n`, which must not be negative.
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
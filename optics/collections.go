@@ -0,0 +1,128 @@
+package optics
+
+import (
+	"github.com/cr7pt0gr4ph7/functional-go/collections/heterogeneous/hmap"
+	"github.com/cr7pt0gr4ph7/functional-go/collections/lists"
+	"github.com/cr7pt0gr4ph7/functional-go/collections/maps"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+)
+
+// Since the collections below are mutable, every update
+// copies the collection before modifying the copy.
+
+// ==========
+// :: Maps ::
+// ==========
+
+// MapAt returns a lens that focuses on the entry for `key`,
+// which is `None` if the key is not present.
+//
+// Setting `None` removes the entry.
+func MapAt[K comparable, V any](key K) Lens[maps.Map[K, V], option.Optional[V]] {
+	return NewLens(func(m maps.Map[K, V]) option.Optional[V] {
+		return option.FromValueOrFalse(m.TryAt(key))
+	}, func(m maps.Map[K, V], o option.Optional[V]) maps.Map[K, V] {
+		r := cloneMap(m)
+		if v, ok := o.Value(); ok {
+			r.ReplaceAt(key, v)
+		} else {
+			r.RemoveAt(key)
+		}
+		return r
+	})
+}
+
+// MapKey returns an Optional that focuses on the value for `key`.
+//
+// Unlike `MapAt`, it cannot be used to add or remove entries.
+func MapKey[K comparable, V any](key K) Optional[maps.Map[K, V], V] {
+	return ComposeOptional(MapAt[K, V](key).AsOptional(), Some[V]().AsOptional())
+}
+
+// MapValues returns a Traversal that focuses on all values of a map.
+//
+// The order of the values returned by `GetAll` is unspecified.
+func MapValues[K comparable, V any]() Traversal[maps.Map[K, V], V] {
+	return NewTraversal(func(m maps.Map[K, V]) []V {
+		r := make([]V, 0, len(m))
+		for _, v := range m {
+			r = append(r, v)
+		}
+		return r
+	}, func(m maps.Map[K, V], f func(v V) V) maps.Map[K, V] {
+		r := make(maps.Map[K, V], len(m))
+		for k, v := range m {
+			r[k] = f(v)
+		}
+		return r
+	})
+}
+
+func cloneMap[K comparable, V any](m maps.Map[K, V]) maps.Map[K, V] {
+	r := make(maps.Map[K, V], len(m)+1)
+	for k, v := range m {
+		r[k] = v
+	}
+	return r
+}
+
+// ===========
+// :: Lists ::
+// ===========
+
+// ArrayListIndex returns an Optional that focuses on the element at `index`,
+// which is absent if the index is out of range.
+func ArrayListIndex[T any](index int) Optional[lists.ArrayList[T], T] {
+	return NewOptional(func(l lists.ArrayList[T]) option.Optional[T] {
+		return option.FromValueOrFalse(l.TryAt(index))
+	}, func(l lists.ArrayList[T], t T) lists.ArrayList[T] {
+		r := make(lists.ArrayList[T], len(l))
+		copy(r, l)
+		r[index] = t
+		return r
+	})
+}
+
+// ArrayListElements returns a Traversal that focuses on all elements of a list.
+func ArrayListElements[T any]() Traversal[lists.ArrayList[T], T] {
+	return NewTraversal(func(l lists.ArrayList[T]) []T {
+		r := make([]T, len(l))
+		copy(r, l)
+		return r
+	}, func(l lists.ArrayList[T], f func(t T) T) lists.ArrayList[T] {
+		r := make(lists.ArrayList[T], len(l))
+		for i, t := range l {
+			r[i] = f(t)
+		}
+		return r
+	})
+}
+
+// ========================
+// :: Heterogeneous maps ::
+// ========================
+
+// HMapAt returns a lens that focuses on the entry for `key`,
+// which is `None` if the key is not present.
+//
+// Setting `None` removes the entry.
+func HMapAt[T any](key hmap.Key[T]) Lens[hmap.Map, option.Optional[T]] {
+	return NewLens(func(m hmap.Map) option.Optional[T] {
+		return option.FromValueOrFalse(hmap.Get(m, key))
+	}, func(m hmap.Map, o option.Optional[T]) hmap.Map {
+		r := m.Clone()
+		if v, ok := o.Value(); ok {
+			hmap.Put(r, key, v)
+		} else {
+			hmap.Remove(r, key)
+		}
+		return r
+	})
+}
+
+// HMapKey returns an Optional that focuses on the value for `key`.
+//
+// Unlike `HMapAt`, it cannot be used to add or remove entries.
+func HMapKey[T any](key hmap.Key[T]) Optional[hmap.Map, T] {
+	return ComposeOptional(HMapAt(key).AsOptional(), Some[T]().AsOptional())
+}
@@ -0,0 +1,59 @@
+// Package optics provides composable accessors for immutable data structures.
+//
+// A `Lens` focuses on exactly one part of a structure, a `Prism` on one case
+// of a sum type, an `Optional` on a part that may be absent, and a `Traversal`
+// on any number of parts. Updating a structure through an optic never modifies
+// the original structure, but returns an updated copy.
+package optics
+
+import (
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+)
+
+// Lens focuses on a part `A` that is always present in a structure `S`.
+type Lens[S any, A any] struct {
+	get func(s S) A
+	set func(s S, a A) S
+}
+
+// NewLens returns a lens that reads the focused part using `get`,
+// and returns an updated copy of the structure using `set`.
+func NewLens[S any, A any](get func(s S) A, set func(s S, a A) S) Lens[S, A] {
+	return Lens[S, A]{get: get, set: set}
+}
+
+// Get returns the part of `s` that the lens focuses on.
+func (l Lens[S, A]) Get(s S) A {
+	return l.get(s)
+}
+
+// Set returns a copy of `s` with the focused part replaced by `a`.
+func (l Lens[S, A]) Set(s S, a A) S {
+	return l.set(s, a)
+}
+
+// Modify returns a copy of `s` with `f` applied to the focused part.
+func (l Lens[S, A]) Modify(s S, f func(a A) A) S {
+	return l.set(s, f(l.get(s)))
+}
+
+// AsOptional returns an Optional that always finds the focused part.
+func (l Lens[S, A]) AsOptional() Optional[S, A] {
+	return NewOptional(func(s S) option.Optional[A] {
+		return option.Some(l.get(s))
+	}, l.set)
+}
+
+// AsTraversal returns a Traversal that focuses on exactly one part.
+func (l Lens[S, A]) AsTraversal() Traversal[S, A] {
+	return l.AsOptional().AsTraversal()
+}
+
+// ComposeLens returns a lens that focuses on the part `B` of the part `A` of `S`.
+func ComposeLens[S any, A any, B any](outer Lens[S, A], inner Lens[A, B]) Lens[S, B] {
+	return NewLens(func(s S) B {
+		return inner.get(outer.get(s))
+	}, func(s S, b B) S {
+		return outer.set(s, inner.set(outer.get(s), b))
+	})
+}
@@ -0,0 +1,118 @@
+package optics_test
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/cr7pt0gr4ph7/functional-go/collections/heterogeneous/hmap"
+	"github.com/cr7pt0gr4ph7/functional-go/collections/lists"
+	fmaps "github.com/cr7pt0gr4ph7/functional-go/collections/maps"
+	"github.com/cr7pt0gr4ph7/functional-go/laws"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+	"github.com/cr7pt0gr4ph7/functional-go/optics"
+	"github.com/cr7pt0gr4ph7/functional-go/quickcheck"
+)
+
+type address struct {
+	street string
+	city   string
+}
+
+type person struct {
+	name    string
+	address address
+}
+
+var personAddress = optics.NewLens(
+	func(p person) address { return p.address },
+	func(p person, a address) person { p.address = a; return p },
+)
+
+var addressCity = optics.NewLens(
+	func(a address) string { return a.city },
+	func(a address, city string) address { a.city = city; return a },
+)
+
+func ExampleComposeLens() {
+	personCity := optics.ComposeLens(personAddress, addressCity)
+
+	alice := person{"Alice", address{"Main St", "Springfield"}}
+	moved := personCity.Set(alice, "Shelbyville")
+	fmt.Println(alice.address.city, moved.address.city)
+	// Output: Springfield Shelbyville
+}
+
+func ExampleMapKey() {
+	ages := fmaps.Map[string, int]{"alice": 30, "bob": 25}
+	older := optics.MapKey[string, int]("alice").Modify(ages, func(age int) int { return age + 1 })
+	same := optics.MapKey[string, int]("carol").Set(ages, 99)
+	fmt.Println(ages, older, same)
+	// Output: map[alice:30 bob:25] map[alice:31 bob:25] map[alice:30 bob:25]
+}
+
+func ExampleComposeTraversal() {
+	teams := fmaps.Map[string, lists.ArrayList[int]]{
+		"red":  {1, 2},
+		"blue": {3},
+	}
+	scores := optics.ComposeTraversal(
+		optics.MapValues[string, lists.ArrayList[int]](),
+		optics.ArrayListElements[int]())
+	doubled := scores.Modify(teams, func(x int) int { return x * 2 })
+	fmt.Println(doubled)
+	// Output: map[blue:[6] red:[2 4]]
+}
+
+func ExampleHMapKey() {
+	name := hmap.NewKey[string]("name")
+	m := hmap.New()
+	hmap.Put(m, name, "alice")
+
+	updated := optics.HMapKey(name).Modify(m, func(s string) string { return s + "!" })
+	fmt.Println(optics.HMapKey(name).GetOption(m).Value())
+	fmt.Println(optics.HMapKey(name).GetOption(updated).Value())
+	// Output:
+	// alice true
+	// alice! true
+}
+
+func eq[A comparable](x A, y A) bool {
+	return x == y
+}
+
+func mapEq(x fmaps.Map[string, int], y fmaps.Map[string, int]) bool {
+	return maps.Equal(x, y)
+}
+
+var keys = quickcheck.Elements("a", "b", "c")
+
+var intMaps = quickcheck.Map(quickcheck.MapOf(keys, quickcheck.Int()), func(m map[string]int) fmaps.Map[string, int] {
+	return m
+})
+
+func TestLensLaws(t *testing.T) {
+	people := quickcheck.Map3(quickcheck.String(), quickcheck.String(), quickcheck.String(),
+		func(name string, street string, city string) person {
+			return person{name, address{street, city}}
+		})
+	laws.Check(t, laws.Config{}, laws.LensLaws(optics.ComposeLens(personAddress, addressCity), people, quickcheck.String(), eq[person], eq[string])...)
+	laws.Check(t, laws.Config{}, laws.LensLaws(optics.MapAt[string, int]("a"), intMaps, quickcheck.OptionalOf(quickcheck.Int()), mapEq, eq[option.Optional[int]])...)
+}
+
+func TestPrismLaws(t *testing.T) {
+	laws.Check(t, laws.Config{}, laws.PrismLaws(optics.Some[int](), quickcheck.OptionalOf(quickcheck.Int()), quickcheck.Int(), eq[option.Optional[int]], eq[int])...)
+}
+
+func TestOptionalLaws(t *testing.T) {
+	laws.Check(t, laws.Config{}, laws.OptionalLaws(optics.MapKey[string, int]("b"), intMaps, quickcheck.Int(), mapEq, eq[int])...)
+
+	arrayLists := quickcheck.Map(quickcheck.SliceOf(quickcheck.Int()), func(s []int) lists.ArrayList[int] {
+		return s
+	})
+	listEq := func(x lists.ArrayList[int], y lists.ArrayList[int]) bool {
+		return slices.Equal(x, y)
+	}
+	laws.Check(t, laws.Config{}, laws.OptionalLaws(optics.ArrayListIndex[int](2), arrayLists, quickcheck.Int(), listEq, eq[int])...)
+}
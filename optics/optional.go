@@ -0,0 +1,63 @@
+package optics
+
+import (
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+)
+
+// Optional focuses on a part `A` of a structure `S` that may be absent.
+//
+// Not to be confused with `option.Optional`, which is the type
+// returned by `GetOption`.
+type Optional[S any, A any] struct {
+	getOption func(s S) option.Optional[A]
+	set       func(s S, a A) S
+}
+
+// NewOptional returns an Optional that looks up the focused part using `getOption`,
+// and returns an updated copy of the structure using `set`.
+//
+// `set` is only called when the focused part is present.
+func NewOptional[S any, A any](getOption func(s S) option.Optional[A], set func(s S, a A) S) Optional[S, A] {
+	return Optional[S, A]{getOption: getOption, set: set}
+}
+
+// GetOption returns the focused part of `s`, or `None` if it is absent.
+func (o Optional[S, A]) GetOption(s S) option.Optional[A] {
+	return o.getOption(s)
+}
+
+// Set returns a copy of `s` with the focused part replaced by `a`,
+// or `s` unchanged if the focused part is absent.
+func (o Optional[S, A]) Set(s S, a A) S {
+	return o.Modify(s, func(_ A) A { return a })
+}
+
+// Modify returns a copy of `s` with `f` applied to the focused part,
+// or `s` unchanged if the focused part is absent.
+func (o Optional[S, A]) Modify(s S, f func(a A) A) S {
+	if a, ok := o.getOption(s).Value(); ok {
+		return o.set(s, f(a))
+	}
+	return s
+}
+
+// AsTraversal returns a Traversal that focuses on zero or one parts.
+func (o Optional[S, A]) AsTraversal() Traversal[S, A] {
+	return NewTraversal(func(s S) []A {
+		if a, ok := o.getOption(s).Value(); ok {
+			return []A{a}
+		}
+		return nil
+	}, o.Modify)
+}
+
+// ComposeOptional returns an Optional that focuses on the part `B` of the part `A` of `S`.
+func ComposeOptional[S any, A any, B any](outer Optional[S, A], inner Optional[A, B]) Optional[S, B] {
+	return NewOptional(func(s S) option.Optional[B] {
+		return option.FlatMap(outer.getOption(s), inner.getOption)
+	}, func(s S, b B) S {
+		return outer.Modify(s, func(a A) A {
+			return inner.Set(a, b)
+		})
+	})
+}
@@ -0,0 +1,68 @@
+package optics
+
+import (
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+)
+
+// Prism focuses on one case `A` of a sum type `S`.
+type Prism[S any, A any] struct {
+	preview func(s S) option.Optional[A]
+	review  func(a A) S
+}
+
+// NewPrism returns a prism that matches the focused case using `preview`,
+// and constructs an `S` from that case using `review`.
+func NewPrism[S any, A any](preview func(s S) option.Optional[A], review func(a A) S) Prism[S, A] {
+	return Prism[S, A]{preview: preview, review: review}
+}
+
+// Preview returns the focused case of `s`, or `None` if `s` is a different case.
+func (p Prism[S, A]) Preview(s S) option.Optional[A] {
+	return p.preview(s)
+}
+
+// Review constructs an `S` from the focused case.
+func (p Prism[S, A]) Review(a A) S {
+	return p.review(a)
+}
+
+// Set replaces `s` by `Review(a)` if `s` is the focused case,
+// and returns `s` unchanged otherwise.
+func (p Prism[S, A]) Set(s S, a A) S {
+	return p.Modify(s, func(_ A) A { return a })
+}
+
+// Modify applies `f` to `s` if `s` is the focused case,
+// and returns `s` unchanged otherwise.
+func (p Prism[S, A]) Modify(s S, f func(a A) A) S {
+	if a, ok := p.preview(s).Value(); ok {
+		return p.review(f(a))
+	}
+	return s
+}
+
+// AsOptional returns an Optional that focuses on the case of the prism.
+func (p Prism[S, A]) AsOptional() Optional[S, A] {
+	return NewOptional(p.preview, p.Set)
+}
+
+// AsTraversal returns a Traversal that focuses on the case of the prism.
+func (p Prism[S, A]) AsTraversal() Traversal[S, A] {
+	return p.AsOptional().AsTraversal()
+}
+
+// ComposePrism returns a prism that focuses on the case `B` of the case `A` of `S`.
+func ComposePrism[S any, A any, B any](outer Prism[S, A], inner Prism[A, B]) Prism[S, B] {
+	return NewPrism(func(s S) option.Optional[B] {
+		return option.FlatMap(outer.preview(s), inner.preview)
+	}, func(b B) S {
+		return outer.review(inner.review(b))
+	})
+}
+
+// Some returns a prism that focuses on the value of a present `option.Optional`.
+func Some[A any]() Prism[option.Optional[A], A] {
+	return NewPrism(func(o option.Optional[A]) option.Optional[A] {
+		return o
+	}, option.Some[A])
+}
@@ -0,0 +1,44 @@
+package optics
+
+// Traversal focuses on any number of parts `A` of a structure `S`.
+type Traversal[S any, A any] struct {
+	getAll func(s S) []A
+	modify func(s S, f func(a A) A) S
+}
+
+// NewTraversal returns a Traversal that lists the focused parts using `getAll`,
+// and returns an updated copy of the structure using `modify`.
+func NewTraversal[S any, A any](getAll func(s S) []A, modify func(s S, f func(a A) A) S) Traversal[S, A] {
+	return Traversal[S, A]{getAll: getAll, modify: modify}
+}
+
+// GetAll returns all parts of `s` that the traversal focuses on.
+func (t Traversal[S, A]) GetAll(s S) []A {
+	return t.getAll(s)
+}
+
+// Set returns a copy of `s` with all focused parts replaced by `a`.
+func (t Traversal[S, A]) Set(s S, a A) S {
+	return t.modify(s, func(_ A) A { return a })
+}
+
+// Modify returns a copy of `s` with `f` applied to all focused parts.
+func (t Traversal[S, A]) Modify(s S, f func(a A) A) S {
+	return t.modify(s, f)
+}
+
+// ComposeTraversal returns a Traversal that focuses on all parts `B`
+// of all parts `A` of `S`.
+func ComposeTraversal[S any, A any, B any](outer Traversal[S, A], inner Traversal[A, B]) Traversal[S, B] {
+	return NewTraversal(func(s S) []B {
+		var r []B
+		for _, a := range outer.getAll(s) {
+			r = append(r, inner.getAll(a)...)
+		}
+		return r
+	}, func(s S, f func(b B) B) S {
+		return outer.modify(s, func(a A) A {
+			return inner.modify(a, f)
+		})
+	})
+}
@@ -0,0 +1,154 @@
+package functional
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+)
+
+// ParConfig configures the parallel folds `ParFoldMap` and `ParReduce`.
+type ParConfig struct {
+	// Number of goroutines that process chunks concurrently.
+	// Defaults to `runtime.GOMAXPROCS(0)` when zero or negative.
+	Workers int
+	// Number of elements per chunk.
+	// Defaults to `DefaultChunkSize` when zero or negative.
+	ChunkSize int
+}
+
+const DefaultChunkSize = 4096
+
+func (c ParConfig) workers() int {
+	if c.Workers <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return c.Workers
+}
+
+func (c ParConfig) chunkSize() int {
+	if c.ChunkSize <= 0 {
+		return DefaultChunkSize
+	}
+	return c.ChunkSize
+}
+
+// ParFoldMap is the parallel version of `FoldMap`.
+//
+// The elements of `foldable` are split into chunks that are folded concurrently,
+// after which the partial results are combined in their original order.
+// Because `monoid` is associative, the result is the same as that of `FoldMap`
+// (but note that e.g. floating-point addition is only approximately associative).
+//
+// Slices are split without copying. Other foldables are traversed sequentially
+// on the calling goroutine, which copies each chunk before handing it off.
+// `f` must be safe to call from multiple goroutines.
+//
+// If `f` or `monoid` panics on a worker goroutine, the remaining chunks are
+// skipped, and the panic is re-raised on the calling goroutine.
+func ParFoldMap[FA Foldable[A], A any, M any](foldable FA, monoid Monoid[M], f func(elem A) M, config ParConfig) M {
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		partial  []M
+		panicked bool
+		panicVal any
+	)
+
+	type chunk struct {
+		index int
+		elems Slice[A]
+	}
+
+	foldChunk := func(c chunk) {
+		defer func() {
+			if p := recover(); p != nil {
+				mu.Lock()
+				if !panicked {
+					panicked, panicVal = true, p
+				}
+				mu.Unlock()
+			}
+		}()
+		r := FoldMap(c.elems, monoid, f)
+
+		mu.Lock()
+		for len(partial) <= c.index {
+			partial = append(partial, monoid.Empty())
+		}
+		partial[c.index] = r
+		mu.Unlock()
+	}
+
+	chunks := make(chan chunk)
+	// Closing the channel stops the workers. This must also happen
+	// if folding `foldable` panics, or the workers would leak.
+	closed := false
+	closeChunks := func() {
+		if !closed {
+			closed = true
+			close(chunks)
+		}
+	}
+	defer closeChunks()
+	for i := 0; i < config.workers(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range chunks {
+				// Keep receiving after a panic, so that the sender is not blocked.
+				mu.Lock()
+				skip := panicked
+				mu.Unlock()
+				if !skip {
+					foldChunk(c)
+				}
+			}
+		}()
+	}
+
+	size := config.chunkSize()
+	index := 0
+	emit := func(elems Slice[A]) {
+		chunks <- chunk{index, elems}
+		index++
+	}
+
+	if s, ok := any(foldable).(Slice[A]); ok {
+		for len(s) > size {
+			emit(s[:size])
+			s = s[size:]
+		}
+		if len(s) > 0 {
+			emit(s)
+		}
+	} else {
+		buf := make(Slice[A], 0, size)
+		foldable.FoldLeft(foldFunc[A](func(elem A) {
+			buf = append(buf, elem)
+			if len(buf) == size {
+				emit(buf)
+				buf = make(Slice[A], 0, size)
+			}
+		}))
+		if len(buf) > 0 {
+			emit(buf)
+		}
+	}
+
+	closeChunks()
+	wg.Wait()
+	if panicked {
+		panic(panicVal)
+	}
+
+	return Fold(Slice[M](partial), monoid)
+}
+
+// ParReduce is the parallel version of `Reduce`, using `semigroup` to combine elements.
+// Returns `None` if `foldable` is empty.
+//
+// See `ParFoldMap` for details.
+func ParReduce[FA Foldable[A], A any](foldable FA, semigroup Semigroup[A], config ParConfig) option.Optional[A] {
+	return ParFoldMap(foldable, OptionMonoid(semigroup), option.Some[A], config)
+}
@@ -0,0 +1,110 @@
+package functional
+
+import (
+	"fmt"
+	"runtime"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/list"
+	"github.com/cr7pt0gr4ph7/functional-go/funcs"
+)
+
+func ExampleParFoldMap() {
+	s := make(Slice[int], 100000)
+	for i := range s {
+		s[i] = i
+	}
+	sum := ParFoldMap(s, SumMonoid[int](), func(x int) int { return x }, ParConfig{Workers: 4, ChunkSize: 1000})
+	fmt.Println(sum)
+	// Output: 4999950000
+}
+
+func TestParFoldMapPreservesOrder(t *testing.T) {
+	s := make(Slice[int], 10007)
+	for i := range s {
+		s[i] = i
+	}
+	// String concatenation is associative, but not commutative,
+	// so any reordering of chunks would change the result.
+	expected := FoldMap(s, StringMonoid(), strconv.Itoa)
+
+	for _, config := range []ParConfig{
+		{},
+		{Workers: 1, ChunkSize: 1},
+		{Workers: 3, ChunkSize: 10},
+		{Workers: 16, ChunkSize: 999},
+		{Workers: 2, ChunkSize: 20000},
+	} {
+		if r := ParFoldMap(s, StringMonoid(), strconv.Itoa, config); r != expected {
+			t.Errorf("%+v: result differs from sequential fold", config)
+		}
+		l := FromIterable(list.New(s...))
+		if r := ParFoldMap(l, StringMonoid(), strconv.Itoa, config); r != expected {
+			t.Errorf("%+v: result differs from sequential fold for list", config)
+		}
+	}
+}
+
+func TestParReduceEmpty(t *testing.T) {
+	if r := ParReduce(Slice[int]{}, MaxSemigroup[int](), ParConfig{}); r.IsPresent() {
+		t.Errorf("expected None, got %v", r)
+	}
+}
+
+func TestParFoldMapPanics(t *testing.T) {
+	s := make(Slice[int], 1000)
+	for i := range s {
+		s[i] = i
+	}
+	config := ParConfig{Workers: 4, ChunkSize: 10}
+	expectPanic := func(name string, run func()) {
+		t.Helper()
+		defer func() {
+			if p := recover(); p != "boom" {
+				t.Errorf("%s: expected the panic to be re-raised, got %v", name, p)
+			}
+		}()
+		run()
+	}
+	expectPanic("f", func() {
+		ParFoldMap(s, SumMonoid[int](), func(x int) int {
+			if x == 500 {
+				panic("boom")
+			}
+			return x
+		}, config)
+	})
+	expectPanic("semigroup", func() {
+		ParReduce(s, NewSemigroup(func(x, y int) int { panic("boom") }), config)
+	})
+	expectPanic("list", func() {
+		ParFoldMap(FromIterable(list.New(s...)), SumMonoid[int](), func(int) int { panic("boom") }, config)
+	})
+}
+
+func TestParFoldMapSourcePanics(t *testing.T) {
+	before := runtime.NumGoroutine()
+	source := Map(FromIterable(list.New(1, 2, 3, 4, 5)), func(x int) int {
+		if x == 4 {
+			panic("boom")
+		}
+		return x
+	})
+	func() {
+		defer func() {
+			if p := recover(); p != "boom" {
+				t.Errorf("expected the panic of the source, got %v", p)
+			}
+		}()
+		ParFoldMap(source, SumMonoid[int](), funcs.Identity[int], ParConfig{Workers: 4, ChunkSize: 1})
+	}()
+	// The workers exit asynchronously after the channel is closed.
+	for i := 0; runtime.NumGoroutine() > before; i++ {
+		if i == 100 {
+			t.Fatalf("workers leaked: %d goroutines, expected %d", runtime.NumGoroutine(), before)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
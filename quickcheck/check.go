@@ -0,0 +1,137 @@
+package quickcheck
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+const (
+	DefaultTrials     = 100
+	DefaultMaxSize    = 100
+	DefaultMaxShrinks = 1000
+)
+
+// Config controls how properties are checked.
+//
+// The zero value is a valid configuration that uses the defaults.
+type Config struct {
+	// Number of random values to test.
+	// Defaults to `DefaultTrials` when zero or negative.
+	Trials int
+	// Seed for the random number generator.
+	// Identical seeds produce identical values.
+	Seed int64
+	// Size hint for the last trial. The size grows linearly
+	// from zero for the first trial up to this value.
+	// Defaults to `DefaultMaxSize` when zero or negative.
+	MaxSize int
+	// Maximum number of successful shrinking steps.
+	// Defaults to `DefaultMaxShrinks` when zero or negative.
+	MaxShrinks int
+}
+
+func (c Config) trials() int {
+	if c.Trials <= 0 {
+		return DefaultTrials
+	}
+	return c.Trials
+}
+
+func (c Config) maxSize() int {
+	if c.MaxSize <= 0 {
+		return DefaultMaxSize
+	}
+	return c.MaxSize
+}
+
+func (c Config) maxShrinks() int {
+	if c.MaxShrinks <= 0 {
+		return DefaultMaxShrinks
+	}
+	return c.MaxShrinks
+}
+
+// Failure describes a value for which a property does not hold.
+type Failure[T any] struct {
+	// Minimal counterexample found by shrinking.
+	Value T
+	// Counterexample originally generated.
+	Original T
+	// Seed that reproduces the failure.
+	Seed int64
+	// Number of the failing trial, starting at zero.
+	Trial int
+	// Number of successful shrinking steps.
+	Shrinks int
+	// Value of the recovered panic, if the property panicked.
+	Panic any
+}
+
+func (f *Failure[T]) Error() string {
+	msg := fmt.Sprintf("property does not hold for %v (seed %d, trial %d, shrunk %d times from %v)",
+		f.Value, f.Seed, f.Trial, f.Shrinks, f.Original)
+	if f.Panic != nil {
+		msg += fmt.Sprintf(": panic: %v", f.Panic)
+	}
+	return msg
+}
+
+// Run tests `property` against values generated by `gen`, and returns
+// a minimal counterexample if the property does not hold, or nil otherwise.
+//
+// A property that panics is considered not to hold.
+func Run[T any](config Config, gen Gen[T], property func(value T) bool) *Failure[T] {
+	r := rand.New(rand.NewSource(config.Seed))
+	trials := config.trials()
+	for trial := 0; trial < trials; trial++ {
+		size := 0
+		if trials > 1 {
+			size = trial * config.maxSize() / (trials - 1)
+		}
+		t := gen.Sample(r, size)
+		if ok, p := holds(property, t.Value); !ok {
+			f := &Failure[T]{Original: t.Value, Seed: config.Seed, Trial: trial}
+			f.Value, f.Shrinks, f.Panic = shrink(t, property, p, config.maxShrinks())
+			return f
+		}
+	}
+	return nil
+}
+
+// Check tests `property` against values generated by `gen`,
+// and reports a minimal counterexample on `t` if the property does not hold.
+func Check[T any](t testing.TB, config Config, gen Gen[T], property func(value T) bool) {
+	t.Helper()
+	if f := Run(config, gen, property); f != nil {
+		t.Error(f)
+	}
+}
+
+// holds evaluates `property`, treating a panic as a failure.
+func holds[T any](property func(value T) bool, value T) (ok bool, p any) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok, p = false, r
+		}
+	}()
+	return property(value), nil
+}
+
+// shrink follows the first failing shrink of `t` until no shrink fails anymore.
+func shrink[T any](t Tree[T], property func(value T) bool, p any, maxShrinks int) (T, int, any) {
+	steps := 0
+	for ; steps < maxShrinks; steps++ {
+		shrunk := false
+		for _, s := range t.Shrinks() {
+			if ok, sp := holds(property, s.Value); !ok {
+				t, p, shrunk = s, sp, true
+				break
+			}
+		}
+		if !shrunk {
+			break
+		}
+	}
+	return t.Value, steps, p
+}
@@ -0,0 +1,136 @@
+package quickcheck
+
+import (
+	"math/rand"
+
+	"github.com/cr7pt0gr4ph7/functional-go/collections/heterogeneous/hlist"
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/chain"
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/list"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+)
+
+// ============
+// :: Slices ::
+// ============
+
+// SliceOf returns a generator for slices of up to `size` elements generated by `g`.
+//
+// Slices shrink by removing elements and by shrinking individual elements.
+func SliceOf[T any](g Gen[T]) Gen[[]T] {
+	return Sized(func(size int) Gen[[]T] {
+		return SliceOfN(g, 0, size)
+	})
+}
+
+// SliceOfN returns a generator for slices of `min` to `max` elements generated by `g`.
+// Slices never shrink below `min` elements.
+func SliceOfN[T any](g Gen[T], min int, max int) Gen[[]T] {
+	if min < 0 || min > max {
+		panic("quickcheck.SliceOfN: invalid length range")
+	}
+	return New(func(r *rand.Rand, size int) Tree[[]T] {
+		ts := make([]Tree[T], min+r.Intn(max-min+1))
+		for i := range ts {
+			ts[i] = g.run(r, size)
+		}
+		return sliceTree(ts, min)
+	})
+}
+
+// ==========
+// :: Maps ::
+// ==========
+
+// MapOf returns a generator for maps with keys and values generated by `kg` and `vg`.
+//
+// Maps shrink by removing entries and by shrinking keys and values.
+// Entries with duplicate keys are collapsed, so the resulting map
+// may contain fewer than `size` entries.
+func MapOf[K comparable, V any](kg Gen[K], vg Gen[V]) Gen[map[K]V] {
+	type entry struct {
+		key   K
+		value V
+	}
+	entries := SliceOf(Map2(kg, vg, func(k K, v V) entry {
+		return entry{k, v}
+	}))
+	return Map(entries, func(es []entry) map[K]V {
+		m := make(map[K]V, len(es))
+		for _, e := range es {
+			m[e.key] = e.value
+		}
+		return m
+	})
+}
+
+// ============
+// :: Monads ::
+// ============
+
+// OptionalOf returns a generator for optional values generated by `g`.
+// About one in four values is `None`. Values shrink towards `None`.
+func OptionalOf[T any](g Gen[T]) Gen[option.Optional[T]] {
+	return New(func(r *rand.Rand, size int) Tree[option.Optional[T]] {
+		if r.Intn(4) == 0 {
+			return Leaf(option.None[T]())
+		}
+		t := MapTree(g.run(r, size), option.Some[T])
+		return NewTree(t.Value, func() []Tree[option.Optional[T]] {
+			return append([]Tree[option.Optional[T]]{Leaf(option.None[T]())}, t.Shrinks()...)
+		})
+	})
+}
+
+// ResultOf returns a generator for results with values generated by `g`
+// and errors generated by `errs`. About one in four values is an error.
+//
+// Ok values shrink like the values of `g`, and errors like the values of `errs`.
+func ResultOf[T any](g Gen[T], errs Gen[error]) Gen[result.Result[T]] {
+	return New(func(r *rand.Rand, size int) Tree[result.Result[T]] {
+		if r.Intn(4) == 0 {
+			return MapTree(errs.run(r, size), result.Error[T])
+		}
+		return MapTree(g.run(r, size), result.Ok[T])
+	})
+}
+
+// ============================
+// :: Persistent collections ::
+// ============================
+
+// ListOf returns a generator for immutable lists of elements generated by `g`.
+// Lists shrink like slices.
+func ListOf[T any](g Gen[T]) Gen[list.List[T]] {
+	return Map(SliceOf(g), func(s []T) list.List[T] {
+		return list.New(s...)
+	})
+}
+
+// ChainOf returns a generator for chains of elements generated by `g`.
+// Chains shrink like slices.
+func ChainOf[T any](g Gen[T]) Gen[chain.Chain[T]] {
+	return Map(SliceOf(g), func(s []T) chain.Chain[T] {
+		// Build the chain from individual pieces to exercise concatenation.
+		var c chain.Chain[T]
+		for _, t := range s {
+			c = c.Append(t)
+		}
+		return c
+	})
+}
+
+// =========================
+// :: Heterogeneous lists ::
+// =========================
+
+// HNil returns a generator for the empty heterogeneous list.
+func HNil() Gen[hlist.Nil] {
+	return Const(hlist.Nil{})
+}
+
+// HCons returns a generator for heterogeneous lists whose head is generated
+// by `head` and whose tail is generated by `tail`.
+func HCons[H any, T hlist.HList](head Gen[H], tail Gen[T]) Gen[hlist.Cons[H, T]] {
+	return Map2(head, tail, hlist.Prepend[H, T])
+}
@@ -0,0 +1,148 @@
+// Package quickcheck provides random generators with integrated shrinking
+// for property-based testing.
+//
+// A `Gen[T]` produces random values of type T together with a tree of
+// simpler variants of each value. When a property fails, the failing value
+// is shrunk along that tree to find a minimal counterexample.
+// Generation is deterministic for a given seed.
+package quickcheck
+
+import (
+	"math/rand"
+)
+
+// Gen generates random values of type T.
+type Gen[T any] struct {
+	run func(r *rand.Rand, size int) Tree[T]
+}
+
+// New returns a generator that calls `run` to generate shrink trees.
+//
+// `size` is a hint for the size of the generated value (e.g. the maximum
+// length of a slice), which grows over the course of a test run.
+func New[T any](run func(r *rand.Rand, size int) Tree[T]) Gen[T] {
+	return Gen[T]{run: run}
+}
+
+// Sample generates a value and its shrinks.
+func (g Gen[T]) Sample(r *rand.Rand, size int) Tree[T] {
+	return g.run(r, size)
+}
+
+// Generate generates a single value using `DefaultMaxSize` as the size hint.
+func (g Gen[T]) Generate(r *rand.Rand) T {
+	return g.run(r, DefaultMaxSize).Value
+}
+
+// Const returns a generator that always produces `value`.
+func Const[T any](value T) Gen[T] {
+	return New(func(_ *rand.Rand, _ int) Tree[T] {
+		return Leaf(value)
+	})
+}
+
+// FromFunc returns a generator that calls `generate` and does not shrink.
+func FromFunc[T any](generate func(r *rand.Rand) T) Gen[T] {
+	return New(func(r *rand.Rand, _ int) Tree[T] {
+		return Leaf(generate(r))
+	})
+}
+
+// Sized returns a generator that depends on the current size hint.
+func Sized[T any](f func(size int) Gen[T]) Gen[T] {
+	return New(func(r *rand.Rand, size int) Tree[T] {
+		return f(size).run(r, size)
+	})
+}
+
+// Resize returns a generator that uses `size` instead of the current size hint.
+func Resize[T any](g Gen[T], size int) Gen[T] {
+	return New(func(r *rand.Rand, _ int) Tree[T] {
+		return g.run(r, size)
+	})
+}
+
+// NoShrink returns a generator that produces the same values as `g`, but does not shrink them.
+func NoShrink[T any](g Gen[T]) Gen[T] {
+	return New(func(r *rand.Rand, size int) Tree[T] {
+		return Leaf(g.run(r, size).Value)
+	})
+}
+
+// Map returns a generator that applies `f` to the values of `g`.
+// Shrinking is preserved.
+func Map[A any, B any](g Gen[A], f func(a A) B) Gen[B] {
+	return New(func(r *rand.Rand, size int) Tree[B] {
+		return MapTree(g.run(r, size), f)
+	})
+}
+
+// FlatMap returns a generator that uses the values of `g` to select the next generator.
+//
+// Values shrink by first shrinking the value of `g` (and regenerating the
+// dependent value), and then by shrinking the dependent value.
+func FlatMap[A any, B any](g Gen[A], f func(a A) Gen[B]) Gen[B] {
+	return New(func(r *rand.Rand, size int) Tree[B] {
+		// Derive a seed for the dependent generator, so that shrinking
+		// the value of `g` regenerates the dependent value deterministically.
+		seed := r.Int63()
+		return bindTree(g.run(r, size), func(a A) Tree[B] {
+			return f(a).run(rand.New(rand.NewSource(seed)), size)
+		})
+	})
+}
+
+// Map2 combines the values of two generators using `f`.
+func Map2[A any, B any, C any](ga Gen[A], gb Gen[B], f func(a A, b B) C) Gen[C] {
+	return New(func(r *rand.Rand, size int) Tree[C] {
+		return zipTree(ga.run(r, size), gb.run(r, size), f)
+	})
+}
+
+// Map3 combines the values of three generators using `f`.
+func Map3[A any, B any, C any, D any](ga Gen[A], gb Gen[B], gc Gen[C], f func(a A, b B, c C) D) Gen[D] {
+	type ab struct {
+		a A
+		b B
+	}
+	return Map2(Map2(ga, gb, func(a A, b B) ab { return ab{a, b} }), gc, func(x ab, c C) D {
+		return f(x.a, x.b, c)
+	})
+}
+
+// Filter returns a generator that only produces values satisfying `predicate`.
+//
+// Generation is retried up to 100 times, after which Filter panics;
+// prefer generating valid values directly where possible.
+func Filter[T any](g Gen[T], predicate func(value T) bool) Gen[T] {
+	return New(func(r *rand.Rand, size int) Tree[T] {
+		for i := 0; i < 100; i++ {
+			if t := g.run(r, size); predicate(t.Value) {
+				return filterTree(t, predicate)
+			}
+		}
+		panic("quickcheck.Filter: too many values discarded")
+	})
+}
+
+// OneOf returns a generator that picks one of `gens` at random.
+// Values shrink towards those of earlier generators.
+func OneOf[T any](gens ...Gen[T]) Gen[T] {
+	if len(gens) == 0 {
+		panic("quickcheck.OneOf: no generators given")
+	}
+	return FlatMap(IntRange(0, len(gens)-1), func(i int) Gen[T] {
+		return gens[i]
+	})
+}
+
+// Elements returns a generator that picks one of `values` at random.
+// Values shrink towards the start of the list.
+func Elements[T any](values ...T) Gen[T] {
+	if len(values) == 0 {
+		panic("quickcheck.Elements: no values given")
+	}
+	return Map(IntRange(0, len(values)-1), func(i int) T {
+		return values[i]
+	})
+}
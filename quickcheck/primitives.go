@@ -0,0 +1,153 @@
+package quickcheck
+
+import (
+	"math"
+	"math/rand"
+	"unicode/utf8"
+)
+
+// ==============
+// :: Integers ::
+// ==============
+
+// Int returns a generator for integers in the range `[-size, size]`
+// that shrinks towards zero.
+func Int() Gen[int] {
+	return Sized(func(size int) Gen[int] {
+		return IntRange(-size, size)
+	})
+}
+
+// IntRange returns a generator for integers in the range `[min, max]`
+// that shrinks towards zero, or the bound closest to zero.
+func IntRange(min int, max int) Gen[int] {
+	if min > max {
+		panic("quickcheck.IntRange: empty range")
+	}
+	origin := 0
+	if origin < min {
+		origin = min
+	} else if origin > max {
+		origin = max
+	}
+	return New(func(r *rand.Rand, _ int) Tree[int] {
+		n := uint64(max) - uint64(min) + 1
+		x := r.Uint64()
+		if n != 0 {
+			x %= n
+		}
+		return intTree(origin, min+int(x))
+	})
+}
+
+func intTree(origin int, x int) Tree[int] {
+	return NewTree(x, func() []Tree[int] {
+		var r []Tree[int]
+		for _, c := range towards(origin, x) {
+			r = append(r, intTree(origin, c))
+		}
+		return r
+	})
+}
+
+// towards returns values between `origin` and `x` (exclusive),
+// starting with `origin` and approaching `x` by halving the distance.
+//
+// `origin` and `x` must have the same sign (or `origin` must be zero),
+// which guarantees that computing the distance does not overflow.
+func towards(origin int, x int) []int {
+	if x == origin {
+		return nil
+	}
+	r := []int{origin}
+	for d := (x - origin) / 2; d != 0; d /= 2 {
+		r = append(r, x-d)
+	}
+	return r
+}
+
+// ====================
+// :: Other numerics ::
+// ====================
+
+// Float64 returns a generator for floating-point numbers in the range `[-size, size]`
+// that shrinks towards zero and towards integral values.
+func Float64() Gen[float64] {
+	return Sized(func(size int) Gen[float64] {
+		return Float64Range(-float64(size), float64(size))
+	})
+}
+
+// Float64Range returns a generator for floating-point numbers in the range `[min, max]`.
+func Float64Range(min float64, max float64) Gen[float64] {
+	if min > max {
+		panic("quickcheck.Float64Range: empty range")
+	}
+	origin := math.Max(min, math.Min(0, max))
+	return New(func(r *rand.Rand, _ int) Tree[float64] {
+		return floatTree(origin, min+r.Float64()*(max-min))
+	})
+}
+
+func floatTree(origin float64, x float64) Tree[float64] {
+	return NewTree(x, func() []Tree[float64] {
+		var r []Tree[float64]
+		for _, c := range []float64{origin, math.Trunc(x), origin + (x-origin)/2} {
+			if c != x && math.Abs(c-origin) < math.Abs(x-origin) {
+				r = append(r, floatTree(origin, c))
+			}
+		}
+		return r
+	})
+}
+
+// Bool returns a generator for booleans that shrinks towards false.
+func Bool() Gen[bool] {
+	return Map(IntRange(0, 1), func(i int) bool {
+		return i == 1
+	})
+}
+
+// ===========
+// :: Runes ::
+// ===========
+
+// ASCIIRune returns a generator for printable ASCII characters that shrinks towards 'a'.
+func ASCIIRune() Gen[rune] {
+	return OneOf(AlphaNumRune(), Map(IntRange(' ', '~'), func(i int) rune {
+		return rune(i)
+	}))
+}
+
+const alphaNum = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// AlphaNumRune returns a generator for ASCII letters and digits that shrinks towards 'a'.
+func AlphaNumRune() Gen[rune] {
+	return Elements([]rune(alphaNum)...)
+}
+
+// Rune returns a generator for arbitrary valid Unicode code points
+// that shrinks towards ASCII characters.
+func Rune() Gen[rune] {
+	nonASCII := Filter(Map(IntRange(0x80, utf8.MaxRune), func(i int) rune {
+		return rune(i)
+	}), utf8.ValidRune)
+	return OneOf(ASCIIRune(), nonASCII)
+}
+
+// =============
+// :: Strings ::
+// =============
+
+// String returns a generator for strings of printable ASCII characters.
+func String() Gen[string] {
+	return StringOf(ASCIIRune())
+}
+
+// StringOf returns a generator for strings consisting of runes generated by `g`.
+// Strings shrink by removing and shrinking runes.
+func StringOf(g Gen[rune]) Gen[string] {
+	return Map(SliceOf(g), func(runes []rune) string {
+		return string(runes)
+	})
+}
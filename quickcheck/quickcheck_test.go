@@ -0,0 +1,91 @@
+package quickcheck
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/cr7pt0gr4ph7/functional-go/collections/heterogeneous/hlist"
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/chain"
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/list"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+)
+
+func ExampleRun() {
+	// A wrong property: "no slice contains an element greater than 20".
+	f := Run(Config{}, SliceOf(Int()), func(s []int) bool {
+		for _, x := range s {
+			if x > 20 {
+				return false
+			}
+		}
+		return true
+	})
+	fmt.Println(f.Value)
+	// Output: [21]
+}
+
+func ExampleMapOf() {
+	// A wrong property: "no map has more than two entries".
+	f := Run(Config{Seed: 7}, MapOf(String(), Bool()), func(m map[string]bool) bool {
+		return len(m) <= 2
+	})
+	fmt.Println(f.Value)
+	// Output: map[:false a:false b:false]
+}
+
+func TestDeterministic(t *testing.T) {
+	gen := SliceOf(Map2(String(), OptionalOf(Float64()), func(s string, f option.Optional[float64]) string {
+		return fmt.Sprint(s, f)
+	}))
+	for seed := int64(0); seed < 10; seed++ {
+		x := gen.Sample(rand.New(rand.NewSource(seed)), 50).Value
+		y := gen.Sample(rand.New(rand.NewSource(seed)), 50).Value
+		if !reflect.DeepEqual(x, y) {
+			t.Errorf("seed %d: generated different values %v and %v", seed, x, y)
+		}
+	}
+}
+
+func TestIntRangeBounds(t *testing.T) {
+	Check(t, Config{}, IntRange(5, 10), func(x int) bool {
+		return x >= 5 && x <= 10
+	})
+	// Shrinking must respect the bounds as well.
+	f := Run(Config{}, IntRange(5, 10), func(x int) bool { return false })
+	if f == nil || f.Value != 5 {
+		t.Errorf("expected minimal counterexample 5, got %v", f)
+	}
+}
+
+func TestSliceOfNMinLength(t *testing.T) {
+	f := Run(Config{}, SliceOfN(Int(), 3, 10), func(s []int) bool { return false })
+	if f == nil || !slices.Equal(f.Value, []int{0, 0, 0}) {
+		t.Errorf("expected minimal counterexample [0 0 0], got %v", f)
+	}
+}
+
+func TestStringsAreValid(t *testing.T) {
+	Check(t, Config{}, StringOf(Rune()), func(s string) bool {
+		return strings.ToValidUTF8(s, "?") == s
+	})
+}
+
+func TestPersistentCollections(t *testing.T) {
+	Check(t, Config{}, ListOf(Int()), func(l list.List[int]) bool {
+		return l.Len() == len(slices.Collect(l.Seq()))
+	})
+	Check(t, Config{}, Map2(ChainOf(Int()), ChainOf(Int()), chain.Chain[int].Concat), func(c chain.Chain[int]) bool {
+		return c.Len() == len(slices.Collect(c.Seq()))
+	})
+}
+
+func TestHList(t *testing.T) {
+	gen := HCons(Int(), HCons(String(), HNil()))
+	Check(t, Config{}, gen, func(l hlist.Cons[int, hlist.Cons[string, hlist.Nil]]) bool {
+		return l.Len() == 2
+	})
+}
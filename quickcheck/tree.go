@@ -0,0 +1,136 @@
+package quickcheck
+
+// Tree is a generated value together with its (lazily computed) shrinks.
+//
+// The shrinks of a value are themselves trees, so that a failing shrink
+// can be shrunk further. Combinators such as `Map` and `FlatMap` transform
+// whole trees, which is what makes shrinking "integrated": a generator
+// derived from another one automatically shrinks the way its source does.
+type Tree[T any] struct {
+	Value   T
+	shrinks func() []Tree[T]
+}
+
+// NewTree returns a tree with the given value and shrinks.
+// `shrinks` may be nil if the value cannot be shrunk.
+func NewTree[T any](value T, shrinks func() []Tree[T]) Tree[T] {
+	return Tree[T]{Value: value, shrinks: shrinks}
+}
+
+// Leaf returns a tree for a value that cannot be shrunk.
+func Leaf[T any](value T) Tree[T] {
+	return Tree[T]{Value: value}
+}
+
+// Shrinks returns the immediate shrinks of the tree, simplest first.
+func (t Tree[T]) Shrinks() []Tree[T] {
+	if t.shrinks == nil {
+		return nil
+	}
+	return t.shrinks()
+}
+
+// MapTree applies `f` to every value in `t`.
+func MapTree[A any, B any](t Tree[A], f func(a A) B) Tree[B] {
+	return Tree[B]{
+		Value: f(t.Value),
+		shrinks: func() []Tree[B] {
+			return mapTrees(t.Shrinks(), f)
+		},
+	}
+}
+
+func mapTrees[A any, B any](ts []Tree[A], f func(a A) B) []Tree[B] {
+	r := make([]Tree[B], len(ts))
+	for i, t := range ts {
+		r[i] = MapTree(t, f)
+	}
+	return r
+}
+
+// bindTree shrinks the outer tree first, regenerating the inner tree for
+// every outer shrink, and then shrinks the inner tree.
+func bindTree[A any, B any](t Tree[A], f func(a A) Tree[B]) Tree[B] {
+	inner := f(t.Value)
+	return Tree[B]{
+		Value: inner.Value,
+		shrinks: func() []Tree[B] {
+			var r []Tree[B]
+			for _, s := range t.Shrinks() {
+				r = append(r, bindTree(s, f))
+			}
+			return append(r, inner.Shrinks()...)
+		},
+	}
+}
+
+// zipTree combines two trees, shrinking the left tree before the right one.
+func zipTree[A any, B any, C any](ta Tree[A], tb Tree[B], f func(a A, b B) C) Tree[C] {
+	return Tree[C]{
+		Value: f(ta.Value, tb.Value),
+		shrinks: func() []Tree[C] {
+			var r []Tree[C]
+			for _, sa := range ta.Shrinks() {
+				r = append(r, zipTree(sa, tb, f))
+			}
+			for _, sb := range tb.Shrinks() {
+				r = append(r, zipTree(ta, sb, f))
+			}
+			return r
+		},
+	}
+}
+
+// filterTree removes all shrinks (and their subtrees) that do not satisfy `predicate`.
+func filterTree[T any](t Tree[T], predicate func(value T) bool) Tree[T] {
+	return Tree[T]{
+		Value: t.Value,
+		shrinks: func() []Tree[T] {
+			var r []Tree[T]
+			for _, s := range t.Shrinks() {
+				if predicate(s.Value) {
+					r = append(r, filterTree(s, predicate))
+				}
+			}
+			return r
+		},
+	}
+}
+
+// sliceTree combines element trees into a tree for the slice of their values.
+//
+// Slices shrink by removing chunks of elements (halves, quarters, ..., single
+// elements), never dropping below `minLen` elements, and then by shrinking
+// individual elements.
+func sliceTree[T any](ts []Tree[T], minLen int) Tree[[]T] {
+	values := make([]T, len(ts))
+	for i, t := range ts {
+		values[i] = t.Value
+	}
+	return Tree[[]T]{
+		Value: values,
+		shrinks: func() []Tree[[]T] {
+			var r []Tree[[]T]
+			for k := len(ts); k > 0; k /= 2 {
+				if len(ts)-k < minLen {
+					continue
+				}
+				for start := 0; start+k <= len(ts); start += k {
+					rest := make([]Tree[T], 0, len(ts)-k)
+					rest = append(rest, ts[:start]...)
+					rest = append(rest, ts[start+k:]...)
+					r = append(r, sliceTree(rest, minLen))
+				}
+			}
+			for i, t := range ts {
+				for _, s := range t.Shrinks() {
+					shrunk := make([]Tree[T], len(ts))
+					copy(shrunk, ts)
+					shrunk[i] = s
+					r = append(r, sliceTree(shrunk, minLen))
+				}
+			}
+			return r
+		},
+	}
+}
@@ -11,5 +11,5 @@ type Observer[T any] interface {
 }
 
 type Subscription interface {
-	Close()
+	Cancel()
 }
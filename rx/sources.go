@@ -32,7 +32,7 @@ func Forever[T any](value T) Observable[T] {
 		for !s.IsCancelled() {
 			observer.Next(value)
 		}
-		return s
+		return &s
 	})
 }
 
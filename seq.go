@@ -0,0 +1,153 @@
+package functional
+
+import (
+	"iter"
+
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/cursor"
+)
+
+// Seq returns an iterator over the elements of `foldable`.
+//
+// If `foldable` is `Iterable` or a `SeqFoldable`, breaking out of the loop
+// stops the traversal. Otherwise, the remaining elements are still visited by `FoldLeft`,
+// but are no longer passed to the loop body.
+func Seq[FA Foldable[A], A any](foldable FA) iter.Seq[A] {
+	return func(yield func(A) bool) {
+		eachWhile[A](foldable, yield)
+	}
+}
+
+// Seq2 returns an iterator over the indices and elements of `foldable`.
+//
+// See `Seq` for details.
+func Seq2[FA Foldable[A], A any](foldable FA) iter.Seq2[int, A] {
+	return func(yield func(int, A) bool) {
+		i := 0
+		eachWhile[A](foldable, func(elem A) bool {
+			if !yield(i, elem) {
+				return false
+			}
+			i++
+			return true
+		})
+	}
+}
+
+// SeqFoldable adapts an `iter.Seq` to the `Foldable` interface.
+type SeqFoldable[A any] iter.Seq[A]
+
+// FromSeq returns a Foldable that traverses the elements produced by `seq`.
+func FromSeq[A any](seq iter.Seq[A]) SeqFoldable[A] {
+	return SeqFoldable[A](seq)
+}
+
+func (s SeqFoldable[A]) FoldLeft(fn FoldLeftFn[A]) {
+	for elem := range s {
+		fn.Next(elem)
+	}
+}
+
+func (s SeqFoldable[A]) Seq() iter.Seq[A] {
+	return iter.Seq[A](s)
+}
+
+// asSeq returns the sequence of `foldable` if it is a `SeqFoldable`.
+func asSeq[A any](foldable Foldable[A]) (iter.Seq[A], bool) {
+	if s, ok := foldable.(SeqFoldable[A]); ok {
+		return iter.Seq[A](s), true
+	}
+	return nil, false
+}
+
+// The following functions implement the lazy adapters in combinators.go
+// for `SeqFoldable`, so that their results can still be stopped early.
+
+func mapSeq[A any, B any](seq iter.Seq[A], f func(elem A) B) iter.Seq[B] {
+	return func(yield func(B) bool) {
+		for elem := range seq {
+			if !yield(f(elem)) {
+				return
+			}
+		}
+	}
+}
+
+func filterSeq[A any](seq iter.Seq[A], predicate func(elem A) bool) iter.Seq[A] {
+	return func(yield func(A) bool) {
+		for elem := range seq {
+			if predicate(elem) && !yield(elem) {
+				return
+			}
+		}
+	}
+}
+
+func takeSeq[A any](seq iter.Seq[A], n int) iter.Seq[A] {
+	return func(yield func(A) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for elem := range seq {
+			if !yield(elem) {
+				return
+			}
+			if i++; i >= n {
+				return
+			}
+		}
+	}
+}
+
+func takeWhileSeq[A any](seq iter.Seq[A], predicate func(elem A) bool) iter.Seq[A] {
+	return func(yield func(A) bool) {
+		for elem := range seq {
+			if !predicate(elem) || !yield(elem) {
+				return
+			}
+		}
+	}
+}
+
+func dropSeq[A any](seq iter.Seq[A], n int) iter.Seq[A] {
+	return func(yield func(A) bool) {
+		i := 0
+		for elem := range seq {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
+
+func (s Slice[A]) Seq() iter.Seq[A] {
+	return func(yield func(A) bool) {
+		for _, elem := range s {
+			if !yield(elem) {
+				return
+			}
+		}
+	}
+}
+
+func (s Slice[A]) Seq2() iter.Seq2[int, A] {
+	return func(yield func(int, A) bool) {
+		for i, elem := range s {
+			if !yield(i, elem) {
+				return
+			}
+		}
+	}
+}
+
+func (c CursorFoldable[A]) Seq() iter.Seq[A] {
+	return cursor.Seq(c.cursor)
+}
+
+func (c CursorFoldable[A]) Seq2() iter.Seq2[int, A] {
+	return cursor.Seq2(c.cursor)
+}
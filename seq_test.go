@@ -0,0 +1,73 @@
+package functional
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/chain"
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/cursor"
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/list"
+)
+
+func ExampleSeq() {
+	for i, x := range list.New("a", "b", "c").Seq2() {
+		fmt.Println(i, x)
+	}
+	// Breaking out of the loop stops the traversal of infinite sources.
+	for x := range Seq(FromCursor[int](naturals(1))) {
+		if x > 3 {
+			break
+		}
+		fmt.Println(x)
+	}
+	fmt.Println(slices.Collect(chain.New(1, 2).Append(3).Seq()))
+	// Output:
+	// 0 a
+	// 1 b
+	// 2 c
+	// 1
+	// 2
+	// 3
+	// [1 2 3]
+}
+
+func ExampleFromSeq() {
+	fmt.Println(Count(FromSeq(slices.Values([]int{1, 2, 3}))))
+
+	c, stop := cursor.FromSeq(slices.Values([]string{"x", "y", "z"}))
+	defer stop()
+	// Cursors are persistent, so the same cursor can be traversed twice.
+	fmt.Println(ToSlice(Take(FromCursor(c), 2)))
+	fmt.Println(ToSlice(FromCursor(c)))
+	// Output:
+	// 3
+	// [x y]
+	// [x y z]
+}
+
+func TestSeqFoldableStopsEarly(t *testing.T) {
+	naturals := FromSeq(func(yield func(int) bool) {
+		for i := 1; yield(i); i++ {
+		}
+	})
+	for range Seq(naturals) {
+		break
+	}
+	if x, ok := Find(naturals, func(x int) bool { return x > 3 }).Value(); !ok || x != 4 {
+		t.Errorf("expected 4, got %v", x)
+	}
+	if !Exists(naturals, func(x int) bool { return x == 10 }) {
+		t.Error("expected 10 to exist")
+	}
+	if ForAll(naturals, func(x int) bool { return x < 10 }) {
+		t.Error("expected 10 to violate the predicate")
+	}
+	evens := Filter(Map(Drop(naturals, 1), func(x int) int { return 2 * x }), func(x int) bool { return x%4 == 0 })
+	if xs := ToSlice(Take(evens, 3)); !slices.Equal(xs, []int{4, 8, 12}) {
+		t.Errorf("expected [4 8 12], got %v", xs)
+	}
+	if xs := ToSlice(TakeWhile(naturals, func(x int) bool { return x < 4 })); !slices.Equal(xs, []int{1, 2, 3}) {
+		t.Errorf("expected [1 2 3], got %v", xs)
+	}
+}
@@ -1,11 +1,15 @@
 package functional
 
 import (
-	"github.com/cr7pt0gr4ph7/functional-go/eval"
+	"github.com/cr7pt0gr4ph7/functional-go/collections/immutable/cursor"
 )
 
 type Slice[A any] []A
 
+func (s Slice[A]) Cursor() cursor.Cursor[A] {
+	return cursor.FromSlice(s)
+}
+
 func (s Slice[A]) FoldLeft(fn FoldLeftFn[A]) {
 	for _, elem := range s {
 		fn.Next(elem)
@@ -19,12 +23,3 @@ func sliceFoldLeft[A any, B any](s Slice[A], initial B, foldFn func(elem A, stat
 	}
 	return acc
 }
-
-func sliceFoldRight[A any, B any](s Slice[A], final eval.Eval[B], foldFn func(elem A, state eval.Eval[B]) eval.Eval[B]) eval.Eval[B] {
-	return eval.Defer(func() eval.Eval[B] {
-		if len(s) == 0 {
-			return final
-		}
-		return foldFn(s[0], sliceFoldRight(s[1:], final, foldFn))
-	})
-}
@@ -0,0 +1,202 @@
+package functional
+
+import (
+	"github.com/cr7pt0gr4ph7/functional-go/collections/maps"
+	"github.com/cr7pt0gr4ph7/functional-go/tuple"
+)
+
+// Unless noted otherwise, the functions in this file never modify their
+// arguments and return newly allocated slices that do not alias them.
+
+// ==============
+// :: Grouping ::
+// ==============
+
+// GroupBy groups the elements of `s` by the result of `key`.
+//
+// The elements of each group keep their relative order from `s`.
+// The map and each group are freshly allocated; groups never alias `s`.
+func GroupBy[A any, K comparable](s Slice[A], key func(elem A) K) maps.Map[K, Slice[A]] {
+	r := make(maps.Map[K, Slice[A]])
+	for _, elem := range s {
+		k := key(elem)
+		r[k] = append(r[k], elem)
+	}
+	return r
+}
+
+// Partition splits `s` into the elements that satisfy `predicate`
+// and those that do not, keeping their relative order.
+//
+// Both results are freshly allocated and do not alias `s`;
+// a result is nil if it has no elements.
+func Partition[A any](s Slice[A], predicate func(elem A) bool) (matching Slice[A], rest Slice[A]) {
+	for _, elem := range s {
+		if predicate(elem) {
+			matching = append(matching, elem)
+		} else {
+			rest = append(rest, elem)
+		}
+	}
+	return
+}
+
+// Distinct returns the elements of `s` without duplicates,
+// keeping the first occurrence of each element.
+//
+// Like `DistinctBy`, it returns a fresh slice, or nil if `s` is empty.
+func Distinct[A comparable](s Slice[A]) Slice[A] {
+	return DistinctBy(s, func(elem A) A {
+		return elem
+	})
+}
+
+// DistinctBy returns the elements of `s` without those for which `key`
+// returns the same value as for an earlier element.
+//
+// The result is a fresh slice that does not alias `s`, or nil if `s` is empty.
+func DistinctBy[A any, K comparable](s Slice[A], key func(elem A) K) Slice[A] {
+	seen := make(map[K]struct{})
+	var r Slice[A]
+	for _, elem := range s {
+		k := key(elem)
+		if _, ok := seen[k]; !ok {
+			seen[k] = struct{}{}
+			r = append(r, elem)
+		}
+	}
+	return r
+}
+
+// ================
+// :: Subslicing ::
+// ================
+
+// Chunk splits `s` into consecutive chunks of `size` elements.
+// The last chunk is shorter if `len(s)` is not a multiple of `size`.
+// Panics if `size` is not positive.
+//
+// The chunks alias `s`: no elements are copied, and only the outer slice
+// is allocated. Their capacity is clipped, so appending to a chunk never
+// overwrites the elements of the following chunk.
+func Chunk[A any](s Slice[A], size int) Slice[Slice[A]] {
+	if size <= 0 {
+		panic("Chunk: size must be positive")
+	}
+	r := make(Slice[Slice[A]], 0, (len(s)+size-1)/size)
+	for len(s) > size {
+		r = append(r, s[:size:size])
+		s = s[size:]
+	}
+	if len(s) > 0 {
+		r = append(r, s[:len(s):len(s)])
+	}
+	return r
+}
+
+// Window returns all contiguous subslices of `s` with `size` elements,
+// in order of their starting index. Returns an empty slice if `s` has
+// fewer than `size` elements. Panics if `size` is not positive.
+//
+// Like the result of `Chunk`, the windows alias `s` and have their capacity clipped.
+func Window[A any](s Slice[A], size int) Slice[Slice[A]] {
+	if size <= 0 {
+		panic("Window: size must be positive")
+	}
+	if len(s) < size {
+		return Slice[Slice[A]]{}
+	}
+	r := make(Slice[Slice[A]], 0, len(s)-size+1)
+	for i := 0; i+size <= len(s); i++ {
+		r = append(r, s[i:i+size:i+size])
+	}
+	return r
+}
+
+// =============
+// :: Zipping ::
+// =============
+
+// Zip pairs up the elements of `as` and `bs` by index.
+// The result is as long as the shorter of both slices.
+//
+// The result is a fresh slice of pairs holding copies of the elements.
+func Zip[A any, B any](as Slice[A], bs Slice[B]) Slice[tuple.Pair[A, B]] {
+	return ZipWith(as, bs, tuple.NewPair[A, B])
+}
+
+// ZipWith combines the elements of `as` and `bs` with the same index using `f`.
+// The result is as long as the shorter of both slices.
+//
+// The result is a fresh slice, allocated once with its final length.
+func ZipWith[A any, B any, C any](as Slice[A], bs Slice[B], f func(a A, b B) C) Slice[C] {
+	n := min(len(as), len(bs))
+	r := make(Slice[C], n)
+	for i := 0; i < n; i++ {
+		r[i] = f(as[i], bs[i])
+	}
+	return r
+}
+
+// Unzip splits a slice of pairs into the slices of their components.
+//
+// Both results are fresh slices, each allocated once with the length of `ps`.
+func Unzip[A any, B any](ps Slice[tuple.Pair[A, B]]) (Slice[A], Slice[B]) {
+	as := make(Slice[A], len(ps))
+	bs := make(Slice[B], len(ps))
+	for i, p := range ps {
+		as[i], bs[i] = p.First, p.Second
+	}
+	return as, bs
+}
+
+// ====================
+// :: Transformation ::
+// ====================
+
+// Intersperse returns the elements of `s` with `separator` between each two of them.
+//
+// The result is a fresh slice, allocated once with its final length.
+func Intersperse[A any](s Slice[A], separator A) Slice[A] {
+	if len(s) == 0 {
+		return Slice[A]{}
+	}
+	r := make(Slice[A], 0, 2*len(s)-1)
+	r = append(r, s[0])
+	for _, elem := range s[1:] {
+		r = append(r, separator, elem)
+	}
+	return r
+}
+
+// Scan is like `FoldLeft`, but returns all intermediate results.
+//
+// The result has `len(s)+1` elements and starts with `initial`;
+// its last element is the result of the corresponding fold.
+// The result is a fresh slice, allocated once with its final length.
+func Scan[A any, B any](s Slice[A], initial B, scanFn func(elem A, acc B) B) Slice[B] {
+	r := make(Slice[B], 0, len(s)+1)
+	acc := initial
+	r = append(r, acc)
+	for _, elem := range s {
+		acc = scanFn(elem, acc)
+		r = append(r, acc)
+	}
+	return r
+}
+
+// Flatten concatenates the slices in `ss`.
+//
+// The result is a fresh slice that does not alias any of `ss`,
+// allocated once with the exact total length.
+func Flatten[S ~[]A, A any](ss []S) Slice[A] {
+	n := 0
+	for _, s := range ss {
+		n += len(s)
+	}
+	r := make(Slice[A], 0, n)
+	for _, s := range ss {
+		r = append(r, s...)
+	}
+	return r
+}
@@ -0,0 +1,87 @@
+package functional
+
+import (
+	"fmt"
+	"testing"
+)
+
+func ExampleGroupBy() {
+	words := Slice[string]{"apple", "avocado", "banana", "blueberry", "cherry"}
+	groups := GroupBy(words, func(w string) byte { return w[0] })
+	fmt.Println(groups['a'], groups['b'], groups['c'])
+	// Output: [apple avocado] [banana blueberry] [cherry]
+}
+
+func ExamplePartition() {
+	even, odd := Partition(Slice[int]{1, 2, 3, 4, 5}, func(x int) bool { return x%2 == 0 })
+	fmt.Println(even, odd)
+	// Output: [2 4] [1 3 5]
+}
+
+func ExampleChunk() {
+	fmt.Println(Chunk(Slice[int]{1, 2, 3, 4, 5}, 2))
+	// Output: [[1 2] [3 4] [5]]
+}
+
+func ExampleWindow() {
+	fmt.Println(Window(Slice[int]{1, 2, 3, 4}, 3))
+	fmt.Println(Window(Slice[int]{1, 2}, 3))
+	// Output:
+	// [[1 2 3] [2 3 4]]
+	// []
+}
+
+func ExampleZip() {
+	pairs := Zip(Slice[string]{"a", "b", "c"}, Slice[int]{1, 2})
+	fmt.Println(pairs)
+	fmt.Println(Unzip(pairs))
+	// Output:
+	// [{a 1} {b 2}]
+	// [a b] [1 2]
+}
+
+func ExampleDistinct() {
+	fmt.Println(Distinct(Slice[int]{3, 1, 3, 2, 1}))
+	// Output: [3 1 2]
+}
+
+func ExampleIntersperse() {
+	fmt.Println(Intersperse(Slice[string]{"a", "b", "c"}, ","))
+	// Output: [a , b , c]
+}
+
+func ExampleScan() {
+	fmt.Println(Scan(Slice[int]{1, 2, 3, 4}, 0, func(x int, acc int) int { return acc + x }))
+	// Output: [0 1 3 6 10]
+}
+
+func ExampleFlatten() {
+	fmt.Println(Flatten(Slice[Slice[int]]{{1, 2}, {}, {3}}))
+	// Output: [1 2 3]
+}
+
+func TestChunkAliasing(t *testing.T) {
+	s := Slice[int]{1, 2, 3, 4}
+	chunks := Chunk(s, 2)
+
+	// Writes are visible through the original slice...
+	chunks[0][1] = 20
+	if s[1] != 20 {
+		t.Errorf("expected chunk to alias the original slice")
+	}
+
+	// ...but appends must not clobber the next chunk.
+	_ = append(chunks[0], 99)
+	if s[2] != 3 || chunks[1][0] != 3 {
+		t.Errorf("append to chunk overwrote following elements: %v", s)
+	}
+}
+
+func TestChunkPanicsOnInvalidSize(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected panic")
+		}
+	}()
+	Chunk(Slice[int]{1}, 0)
+}
@@ -1,5 +1,9 @@
 package functional
 
+import (
+	"fmt"
+)
+
 func ExampleSlice_FoldLeft() {
 	s := Slice[int]{1, 2, 3, 4}
 	r := FoldLeft(s, 10, func(elem int, state int) int {
@@ -0,0 +1,133 @@
+package functional
+
+import (
+	"errors"
+
+	"github.com/cr7pt0gr4ph7/functional-go/eval"
+	"github.com/cr7pt0gr4ph7/functional-go/funcs"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/identity"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+)
+
+// ==============
+// :: Optional ::
+// ==============
+
+// TraverseOption applies `f` to each element of `foldable` and collects the results.
+// Returns `None` as soon as `f` returns `None` for any element.
+func TraverseOption[FA Foldable[A], A any, B any](foldable FA, f func(elem A) option.Optional[B]) option.Optional[Slice[B]] {
+	var r Slice[B]
+	ok := true
+	eachWhile[A](foldable, func(elem A) bool {
+		var b B
+		if b, ok = f(elem).Value(); ok {
+			r = append(r, b)
+		}
+		return ok
+	})
+	return option.FromValueOrFalse(r, ok)
+}
+
+// SequenceOption turns a collection of optional values into an optional collection.
+// Returns `None` if any element is `None`.
+func SequenceOption[FA Foldable[option.Optional[A]], A any](foldable FA) option.Optional[Slice[A]] {
+	return TraverseOption(foldable, funcs.Identity[option.Optional[A]])
+}
+
+// ============
+// :: Result ::
+// ============
+
+// TraverseResult applies `f` to each element of `foldable` and collects the results.
+// Stops at the first error and returns it.
+func TraverseResult[FA Foldable[A], A any, B any](foldable FA, f func(elem A) result.Result[B]) result.Result[Slice[B]] {
+	var r Slice[B]
+	var err error
+	eachWhile[A](foldable, func(elem A) bool {
+		var b B
+		if b, err = f(elem).Extract(); err == nil {
+			r = append(r, b)
+		}
+		return err == nil
+	})
+	if err != nil {
+		return result.Error[Slice[B]](err)
+	}
+	return result.Ok(r)
+}
+
+// SequenceResult turns a collection of results into a result of a collection.
+// Returns the first error encountered, if any.
+func SequenceResult[FA Foldable[result.Result[A]], A any](foldable FA) result.Result[Slice[A]] {
+	return TraverseResult(foldable, funcs.Identity[result.Result[A]])
+}
+
+// TraverseResultAll applies `f` to each element of `foldable` and collects the results.
+// Unlike `TraverseResult`, all elements are visited, and all errors are returned
+// as a single error created by `errors.Join`.
+func TraverseResultAll[FA Foldable[A], A any, B any](foldable FA, f func(elem A) result.Result[B]) result.Result[Slice[B]] {
+	var r Slice[B]
+	var errs []error
+	Each(foldable, func(elem A) {
+		if b, err := f(elem).Extract(); err != nil {
+			errs = append(errs, err)
+		} else if len(errs) == 0 {
+			r = append(r, b)
+		}
+	})
+	if err := errors.Join(errs...); err != nil {
+		return result.Error[Slice[B]](err)
+	}
+	return result.Ok(r)
+}
+
+// SequenceResultAll turns a collection of results into a result of a collection.
+// Returns all errors encountered as a single error created by `errors.Join`.
+func SequenceResultAll[FA Foldable[result.Result[A]], A any](foldable FA) result.Result[Slice[A]] {
+	return TraverseResultAll(foldable, funcs.Identity[result.Result[A]])
+}
+
+// ==========
+// :: Eval ::
+// ==========
+
+// TraverseEval applies `f` to each element of `foldable` and collects the results.
+//
+// Neither `f` nor the returned Evals are invoked until the result is evaluated.
+// Like `eval.Always`, the result is recomputed on every call to `Value()`
+// unless it is memoized; memoized Evals returned by `f` are not evaluated twice.
+func TraverseEval[FA Foldable[A], A any, B any](foldable FA, f func(elem A) eval.Eval[B]) eval.Eval[Slice[B]] {
+	return eval.Always(func() Slice[B] {
+		var r Slice[B]
+		Each(foldable, func(elem A) {
+			r = append(r, f(elem).Value())
+		})
+		return r
+	})
+}
+
+// SequenceEval turns a collection of Evals into an Eval of a collection.
+//
+// See `TraverseEval` for details.
+func SequenceEval[FA Foldable[eval.Eval[A]], A any](foldable FA) eval.Eval[Slice[A]] {
+	return TraverseEval(foldable, funcs.Identity[eval.Eval[A]])
+}
+
+// ==============
+// :: Identity ::
+// ==============
+
+// TraverseIdentity applies `f` to each element of `foldable` and collects the results.
+func TraverseIdentity[FA Foldable[A], A any, B any](foldable FA, f func(elem A) identity.Identity[B]) identity.Identity[Slice[B]] {
+	var r Slice[B]
+	Each(foldable, func(elem A) {
+		r = append(r, f(elem).Value)
+	})
+	return identity.Return(r)
+}
+
+// SequenceIdentity turns a collection of Identity values into an Identity of a collection.
+func SequenceIdentity[FA Foldable[identity.Identity[A]], A any](foldable FA) identity.Identity[Slice[A]] {
+	return TraverseIdentity(foldable, funcs.Identity[identity.Identity[A]])
+}
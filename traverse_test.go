@@ -0,0 +1,54 @@
+package functional
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cr7pt0gr4ph7/functional-go/eval"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/option"
+	"github.com/cr7pt0gr4ph7/functional-go/monads/result"
+)
+
+func ExampleSequenceOption() {
+	fmt.Println(SequenceOption(Slice[option.Optional[int]]{option.Some(1), option.Some(2)}).Value())
+	fmt.Println(SequenceOption(Slice[option.Optional[int]]{option.Some(1), option.None[int]()}).Value())
+	// Output:
+	// [1 2] true
+	// [] false
+}
+
+func ExampleTraverseResult() {
+	parse := result.Wrap1(strconv.Atoi)
+	fmt.Println(TraverseResult(Slice[string]{"1", "2", "3"}, parse).Extract())
+	fmt.Println(TraverseResult(Slice[string]{"1", "x", "y"}, parse).Error())
+	// Output:
+	// [1 2 3] <nil>
+	// strconv.Atoi: parsing "x": invalid syntax
+}
+
+func ExampleTraverseResultAll() {
+	parse := result.Wrap1(strconv.Atoi)
+	err := TraverseResultAll(Slice[string]{"1", "x", "y"}, parse).Error()
+	fmt.Println(err)
+	fmt.Println(len(err.(interface{ Unwrap() []error }).Unwrap()))
+	// Output:
+	// strconv.Atoi: parsing "x": invalid syntax
+	// strconv.Atoi: parsing "y": invalid syntax
+	// 2
+}
+
+func ExampleSequenceEval() {
+	s := Slice[eval.Eval[int]]{
+		eval.Now(1),
+		eval.Later(func() int { fmt.Println("computing"); return 2 }),
+	}
+	r := SequenceEval(s)
+	fmt.Println("not yet evaluated")
+	fmt.Println(r.Value())
+	fmt.Println(r.Value())
+	// Output:
+	// not yet evaluated
+	// computing
+	// [1 2]
+	// [1 2]
+}
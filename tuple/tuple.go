@@ -0,0 +1,221 @@
+// Package tuple provides generic product types.
+//
+// Since Go methods cannot introduce type parameters, operations that change
+// the component types (like `MapFirst`) are functions instead of methods.
+package tuple
+
+import (
+	"github.com/cr7pt0gr4ph7/functional-go/collections/heterogeneous/hlist"
+	"github.com/cr7pt0gr4ph7/functional-go/collections/maps"
+)
+
+// ==========
+// :: Pair ::
+// ==========
+
+// Pair holds two values of possibly different types.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// NewPair returns a Pair of `first` and `second`.
+func NewPair[A any, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{first, second}
+}
+
+// Values returns the components of `p`.
+func (p Pair[A, B]) Values() (A, B) {
+	return p.First, p.Second
+}
+
+// Swap returns a Pair with the components of `p` exchanged.
+func (p Pair[A, B]) Swap() Pair[B, A] {
+	return Pair[B, A]{p.Second, p.First}
+}
+
+// Entry converts `p` to a map entry, using the first component as key.
+func (p Pair[A, B]) Entry() maps.Entry[A, B] {
+	return maps.Entry[A, B]{Key: p.First, Value: p.Second}
+}
+
+// HList converts `p` to a heterogeneous list with two elements.
+func (p Pair[A, B]) HList() hlist.Cons[A, hlist.Cons[B, hlist.Nil]] {
+	return hlist.Cons[A, hlist.Cons[B, hlist.Nil]]{Head: p.First, Tail: hlist.Cons[B, hlist.Nil]{Head: p.Second}}
+}
+
+// PairFromEntry converts a map entry to a Pair of its key and value.
+func PairFromEntry[K any, V any](e maps.Entry[K, V]) Pair[K, V] {
+	return Pair[K, V]{e.Key, e.Value}
+}
+
+// PairFromHList converts a heterogeneous list with two elements to a Pair.
+func PairFromHList[A any, B any](l hlist.Cons[A, hlist.Cons[B, hlist.Nil]]) Pair[A, B] {
+	return Pair[A, B]{l.Head, l.Tail.Head}
+}
+
+// MapFirst applies `f` to the first component of `p`.
+func MapFirst[A any, B any, C any](p Pair[A, B], f func(a A) C) Pair[C, B] {
+	return Pair[C, B]{f(p.First), p.Second}
+}
+
+// MapSecond applies `f` to the second component of `p`.
+func MapSecond[A any, B any, C any](p Pair[A, B], f func(b B) C) Pair[A, C] {
+	return Pair[A, C]{p.First, f(p.Second)}
+}
+
+// Bimap applies `f` to the first and `g` to the second component of `p`.
+func Bimap[A any, B any, C any, D any](p Pair[A, B], f func(a A) C, g func(b B) D) Pair[C, D] {
+	return Pair[C, D]{f(p.First), g(p.Second)}
+}
+
+// CurryPair converts a function taking a Pair into a function taking two arguments.
+func CurryPair[A any, B any, R any](f func(p Pair[A, B]) R) func(a A, b B) R {
+	return func(a A, b B) R {
+		return f(Pair[A, B]{a, b})
+	}
+}
+
+// UncurryPair converts a function taking two arguments into a function taking a Pair.
+func UncurryPair[A any, B any, R any](f func(a A, b B) R) func(p Pair[A, B]) R {
+	return func(p Pair[A, B]) R {
+		return f(p.First, p.Second)
+	}
+}
+
+// ============
+// :: Triple ::
+// ============
+
+// Triple holds three values of possibly different types.
+type Triple[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// NewTriple returns a Triple of `first`, `second` and `third`.
+func NewTriple[A any, B any, C any](first A, second B, third C) Triple[A, B, C] {
+	return Triple[A, B, C]{first, second, third}
+}
+
+// Values returns the components of `t`.
+func (t Triple[A, B, C]) Values() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+// HList converts `t` to a heterogeneous list with three elements.
+func (t Triple[A, B, C]) HList() hlist.Cons[A, hlist.Cons[B, hlist.Cons[C, hlist.Nil]]] {
+	return hlist.Cons[A, hlist.Cons[B, hlist.Cons[C, hlist.Nil]]]{
+		Head: t.First,
+		Tail: Pair[B, C]{t.Second, t.Third}.HList(),
+	}
+}
+
+// TripleFromHList converts a heterogeneous list with three elements to a Triple.
+func TripleFromHList[A any, B any, C any](l hlist.Cons[A, hlist.Cons[B, hlist.Cons[C, hlist.Nil]]]) Triple[A, B, C] {
+	return Triple[A, B, C]{l.Head, l.Tail.Head, l.Tail.Tail.Head}
+}
+
+// MapFirst3 applies `f` to the first component of `t`.
+func MapFirst3[A any, B any, C any, R any](t Triple[A, B, C], f func(a A) R) Triple[R, B, C] {
+	return Triple[R, B, C]{f(t.First), t.Second, t.Third}
+}
+
+// MapSecond3 applies `f` to the second component of `t`.
+func MapSecond3[A any, B any, C any, R any](t Triple[A, B, C], f func(b B) R) Triple[A, R, C] {
+	return Triple[A, R, C]{t.First, f(t.Second), t.Third}
+}
+
+// MapThird3 applies `f` to the third component of `t`.
+func MapThird3[A any, B any, C any, R any](t Triple[A, B, C], f func(c C) R) Triple[A, B, R] {
+	return Triple[A, B, R]{t.First, t.Second, f(t.Third)}
+}
+
+// Trimap applies `f`, `g` and `h` to the respective components of `t`.
+func Trimap[A any, B any, C any, D any, E any, F any](t Triple[A, B, C], f func(a A) D, g func(b B) E, h func(c C) F) Triple[D, E, F] {
+	return Triple[D, E, F]{f(t.First), g(t.Second), h(t.Third)}
+}
+
+// CurryTriple converts a function taking a Triple into a function taking three arguments.
+func CurryTriple[A any, B any, C any, R any](f func(t Triple[A, B, C]) R) func(a A, b B, c C) R {
+	return func(a A, b B, c C) R {
+		return f(Triple[A, B, C]{a, b, c})
+	}
+}
+
+// UncurryTriple converts a function taking three arguments into a function taking a Triple.
+func UncurryTriple[A any, B any, C any, R any](f func(a A, b B, c C) R) func(t Triple[A, B, C]) R {
+	return func(t Triple[A, B, C]) R {
+		return f(t.First, t.Second, t.Third)
+	}
+}
+
+// ==========
+// :: Quad ::
+// ==========
+
+// Quad holds four values of possibly different types.
+type Quad[A any, B any, C any, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
+
+// NewQuad returns a Quad of `first`, `second`, `third` and `fourth`.
+func NewQuad[A any, B any, C any, D any](first A, second B, third C, fourth D) Quad[A, B, C, D] {
+	return Quad[A, B, C, D]{first, second, third, fourth}
+}
+
+// Values returns the components of `q`.
+func (q Quad[A, B, C, D]) Values() (A, B, C, D) {
+	return q.First, q.Second, q.Third, q.Fourth
+}
+
+// HList converts `q` to a heterogeneous list with four elements.
+func (q Quad[A, B, C, D]) HList() hlist.Cons[A, hlist.Cons[B, hlist.Cons[C, hlist.Cons[D, hlist.Nil]]]] {
+	return hlist.Cons[A, hlist.Cons[B, hlist.Cons[C, hlist.Cons[D, hlist.Nil]]]]{
+		Head: q.First,
+		Tail: Triple[B, C, D]{q.Second, q.Third, q.Fourth}.HList(),
+	}
+}
+
+// QuadFromHList converts a heterogeneous list with four elements to a Quad.
+func QuadFromHList[A any, B any, C any, D any](l hlist.Cons[A, hlist.Cons[B, hlist.Cons[C, hlist.Cons[D, hlist.Nil]]]]) Quad[A, B, C, D] {
+	return Quad[A, B, C, D]{l.Head, l.Tail.Head, l.Tail.Tail.Head, l.Tail.Tail.Tail.Head}
+}
+
+// MapFirst4 applies `f` to the first component of `q`.
+func MapFirst4[A any, B any, C any, D any, R any](q Quad[A, B, C, D], f func(a A) R) Quad[R, B, C, D] {
+	return Quad[R, B, C, D]{f(q.First), q.Second, q.Third, q.Fourth}
+}
+
+// MapSecond4 applies `f` to the second component of `q`.
+func MapSecond4[A any, B any, C any, D any, R any](q Quad[A, B, C, D], f func(b B) R) Quad[A, R, C, D] {
+	return Quad[A, R, C, D]{q.First, f(q.Second), q.Third, q.Fourth}
+}
+
+// MapThird4 applies `f` to the third component of `q`.
+func MapThird4[A any, B any, C any, D any, R any](q Quad[A, B, C, D], f func(c C) R) Quad[A, B, R, D] {
+	return Quad[A, B, R, D]{q.First, q.Second, f(q.Third), q.Fourth}
+}
+
+// MapFourth4 applies `f` to the fourth component of `q`.
+func MapFourth4[A any, B any, C any, D any, R any](q Quad[A, B, C, D], f func(d D) R) Quad[A, B, C, R] {
+	return Quad[A, B, C, R]{q.First, q.Second, q.Third, f(q.Fourth)}
+}
+
+// CurryQuad converts a function taking a Quad into a function taking four arguments.
+func CurryQuad[A any, B any, C any, D any, R any](f func(q Quad[A, B, C, D]) R) func(a A, b B, c C, d D) R {
+	return func(a A, b B, c C, d D) R {
+		return f(Quad[A, B, C, D]{a, b, c, d})
+	}
+}
+
+// UncurryQuad converts a function taking four arguments into a function taking a Quad.
+func UncurryQuad[A any, B any, C any, D any, R any](f func(a A, b B, c C, d D) R) func(q Quad[A, B, C, D]) R {
+	return func(q Quad[A, B, C, D]) R {
+		return f(q.First, q.Second, q.Third, q.Fourth)
+	}
+}
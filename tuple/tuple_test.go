@@ -0,0 +1,48 @@
+package tuple
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cr7pt0gr4ph7/functional-go/collections/maps"
+)
+
+func ExamplePair() {
+	p := NewPair("answer", 42)
+	fmt.Println(p.Swap())
+	fmt.Println(MapFirst(p, strings.ToUpper))
+	fmt.Println(MapSecond(p, strconv.Itoa))
+	fmt.Println(Bimap(p, func(s string) int { return len(s) }, func(x int) bool { return x > 0 }))
+	// Output:
+	// {42 answer}
+	// {ANSWER 42}
+	// {answer 42}
+	// {6 true}
+}
+
+func ExamplePairFromEntry() {
+	e := maps.Entry[string, int]{Key: "a", Value: 1}
+	p := PairFromEntry(e)
+	fmt.Println(p, p.Entry() == e)
+	// Output: {a 1} true
+}
+
+func ExampleQuadFromHList() {
+	q := NewQuad(1, "two", 3.0, false)
+	l := q.HList()
+	fmt.Println(l, l.Len())
+	fmt.Println(QuadFromHList(l) == q)
+	// Output:
+	// 1 ::: two ::: 3 ::: false ::: Nil 4
+	// true
+}
+
+func ExampleUncurryPair() {
+	repeat := UncurryPair(strings.Repeat)
+	fmt.Println(repeat(NewPair("ab", 3)))
+	fmt.Println(CurryPair(repeat)("c", 2))
+	// Output:
+	// ababab
+	// cc
+}